@@ -2,12 +2,9 @@ package main
 
 import (
 	"context"
-	"fmt"
 	"log"
-	"time"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/web3sdks/go-sdk/v2/web3sdks"
 )
@@ -29,35 +26,8 @@ func initSdk() {
 	}
 }
 
-func awaitTx(hash common.Hash) (*types.Transaction, error) {
-	provider := web3sdksSDK.GetProvider()
-	wait := time.Second * 1
-	maxAttempts := uint8(20)
-	attempts := uint8(0)
-
-	var syncError error
-	for {
-		if attempts >= maxAttempts {
-			fmt.Println("Retry attempts to get tx exhausted, tx might have failed")
-			return nil, syncError
-		}
-
-		if tx, isPending, err := provider.TransactionByHash(context.Background(), hash); err != nil {
-			syncError = err
-			log.Printf("Failed to get tx %v, err = %v\n", hash.String(), err)
-			attempts += 1
-			time.Sleep(wait)
-			continue
-		} else {
-			if isPending {
-				log.Println("Transaction still pending...")
-				time.Sleep(wait)
-				continue
-			}
-			log.Printf("Transaction with hash %v mined successfully\n", tx.Hash())
-			return tx, nil
-		}
-	}
+func awaitTx(hash common.Hash) (*web3sdks.TransactionResult, error) {
+	return web3sdksSDK.AwaitTx(context.Background(), hash)
 }
 
 func getNftCollection() (*web3sdks.NFTCollection, error) {
@@ -160,7 +160,9 @@ var nftDropClaimCmd = &cobra.Command{
 			panic(err)
 		}
 
-		awaitTx(tx.Hash())
+		if _, err := awaitTx(tx.Hash()); err != nil {
+			panic(err)
+		}
 
 		unclaimed, err := nftDrop.TotalUnclaimedSupply(context.Background())
 		if err != nil {
@@ -8,7 +8,6 @@ import (
 	"fmt"
 	"math"
 	"math/big"
-	"strconv"
 	"strings"
 	"time"
 
@@ -34,7 +33,7 @@ func fetchTokenMetadata(ctx context.Context, tokenId int, uri string, storage st
 			Id: big.NewInt(int64(tokenId)),
 		}
 		if err := json.Unmarshal(body, &metadata); err != nil {
-			return nil, &unmarshalError{body: string(body), typeName: "nft", UnderlyingError: err}
+			return nil, &UnmarshalError{Body: string(body), TypeName: "nft", UnderlyingError: err}
 		}
 
 		return metadata, nil
@@ -90,38 +89,74 @@ func convertQuantityToBigNumber(quantity string, decimals int) (*big.Int, error)
 		MaxUint256 := new(big.Int).Sub(new(big.Int).Lsh(common.Big1, 256), common.Big1)
 		return MaxUint256, nil
 	} else {
-		flt, err := strconv.ParseFloat(quantity, 64)
-		if err != nil {
-			return nil, err
-		}
-
-		return parseUnits(flt, decimals)
+		return parseUnitsString(quantity, decimals)
 	}
 }
 
-// TODO: Update value to be string
+// Convert an amount to its value in the smallest unit of a currency with the given decimals. The
+// shortest decimal representation of the float is used, so that 0.1 is exactly 0.1, and the value
+// is rounded to the smallest unit
 func parseUnits(value float64, decimals int) (*big.Int, error) {
-	floatValue := value * math.Pow(10, float64(decimals))
-	bigNumber, ok := new(big.Int).SetString(fmt.Sprintf("%.0f", floatValue), 10)
-	if !ok {
-		return nil, fmt.Errorf("Failed to parse %f to big.Int", floatValue)
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return nil, fmt.Errorf("Failed to parse %f to big.Int", value)
 	}
 
-	return bigNumber, nil
+	return decimal.NewFromFloat(value).Shift(int32(decimals)).Round(0).BigInt(), nil
 }
 
-func normalizePriceValue(ctx context.Context, provider *ethclient.Client, price float64, currencyAddress string) (*big.Int, error) {
-	metadata, err := fetchCurrencyMetadata(ctx, provider, currencyAddress)
+// Convert an exact decimal amount, like "1.5", to its value in the smallest unit of a currency
+// with the given decimals, failing if the amount has more decimals than the currency
+func parseUnitsString(value string, decimals int) (*big.Int, error) {
+	amount, err := decimal.NewFromString(strings.TrimSpace(value))
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("Failed to parse '%s' to big.Int", value)
+	}
+	if amount.IsNegative() {
+		return nil, fmt.Errorf("Amount '%s' is negative", value)
+	}
+
+	shifted := amount.Shift(int32(decimals))
+	if !shifted.Equal(shifted.Truncate(0)) {
+		return nil, fmt.Errorf("Amount '%s' has more than %d decimals", value, decimals)
+	}
+
+	return shifted.BigInt(), nil
+}
+
+// Get the value in the smallest unit of an amount given as a value in the smallest unit, as an
+// exact decimal string or as a float, using the first one that is set
+func normalizeAmountValue(amount float64, amountString string, amountBigInt *big.Int, decimals int) (*big.Int, error) {
+	if amountBigInt != nil {
+		return amountBigInt, nil
+	}
+	if amountString != "" {
+		return parseUnitsString(amountString, decimals)
+	}
+
+	return parseUnits(amount, decimals)
+}
+
+// Get the value in the smallest unit of a currency of a price given as a value in the smallest
+// unit, as an exact decimal string or as a float, using the first one that is set. The currency
+// metadata is only fetched if the price isn't already in the smallest unit
+func normalizePriceValue(
+	ctx context.Context,
+	provider currencyReader,
+	price float64,
+	priceString string,
+	priceBigInt *big.Int,
+	currencyAddress string,
+) (*big.Int, error) {
+	if priceBigInt != nil {
+		return priceBigInt, nil
 	}
 
-	value, err := parseUnits(price, metadata.Decimals)
+	metadata, err := fetchCurrencyMetadata(ctx, provider, currencyAddress)
 	if err != nil {
 		return nil, err
 	}
 
-	return value, nil
+	return normalizeAmountValue(price, priceString, nil, metadata.Decimals)
 }
 
 func formatUnits(value *big.Int, decimals int) float64 {
@@ -132,13 +167,29 @@ func formatUnits(value *big.Int, decimals int) float64 {
 	return formatted.InexactFloat64()
 }
 
-func fetchCurrencyMetadata(ctx context.Context, provider *ethclient.Client, asset string) (*Currency, error) {
+// Format a value in the smallest unit of a currency with the given decimals as an exact decimal
+// string, like "1.5"
+func formatUnitsString(value *big.Int, decimals int) string {
+	return decimal.NewFromBigInt(value, -int32(decimals)).String()
+}
+
+// The reads needed to get the metadata of a currency. Both the provider and the contract backend
+// implement it, and the contract backend caches the reads when the read cache is enabled.
+type currencyReader interface {
+	bind.ContractCaller
+	ChainID(ctx context.Context) (*big.Int, error)
+}
+
+func fetchCurrencyMetadata(ctx context.Context, provider currencyReader, asset string) (*Currency, error) {
 	if isNativeToken(asset) {
 		chainId, err := provider.ChainID(ctx)
 		if err != nil {
 			return nil, err
 		}
 		nativeToken, err := getNativeTokenByChainId(ChainID(chainId.Int64()))
+		if err != nil {
+			return nil, err
+		}
 		currency := &Currency{
 			nativeToken.name,
 			nativeToken.symbol,
@@ -146,7 +197,7 @@ func fetchCurrencyMetadata(ctx context.Context, provider *ethclient.Client, asse
 		}
 		return currency, nil
 	} else {
-		contractAbi, err := abi.NewTokenERC20(common.HexToAddress(asset), provider)
+		contractAbi, err := abi.NewTokenERC20Caller(common.HexToAddress(asset), provider)
 		if err != nil {
 			return nil, err
 		}
@@ -165,7 +216,7 @@ func fetchCurrencyMetadata(ctx context.Context, provider *ethclient.Client, asse
 	}
 }
 
-func fetchCurrencyValue(ctx context.Context, provider *ethclient.Client, asset string, price *big.Int) (*CurrencyValue, error) {
+func fetchCurrencyValue(ctx context.Context, provider currencyReader, asset string, price *big.Int) (*CurrencyValue, error) {
 	metadata, err := fetchCurrencyMetadata(ctx, provider, asset)
 	if err != nil {
 		return nil, err
@@ -179,6 +230,7 @@ func fetchCurrencyValue(ctx context.Context, provider *ethclient.Client, asset s
 		metadata.Decimals,
 		price,
 		displayValue,
+		formatUnitsString(price, metadata.Decimals),
 	}
 	return currencyValue, nil
 }
@@ -206,12 +258,12 @@ func setErc20AllowanceEncoder(
 
 		if allowance.Cmp(value) < 0 {
 			// We can get options from the contract instead of ERC20 because they will be the same
-			approvalOpts, err := contractToApprove.getUnsignedTxOptions(ctx, signerAddress)
-			if err != nil {
-				return nil, err
-			}
+			tx := newPreparedTx(contractToApprove, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+				return erc20.Approve(opts, spender, value)
+			})
+			tx.From = owner
 
-			return erc20.Approve(approvalOpts, spender, value)
+			return tx.Encode(ctx)
 		}
 	}
 
@@ -229,8 +281,7 @@ func setErc20Allowance(
 		txOpts.Value = value
 		return nil
 	} else {
-		provider := contractToApprove.GetProvider()
-		erc20, err := abi.NewIERC20(common.HexToAddress(currencyAddress), provider)
+		erc20, err := abi.NewIERC20(common.HexToAddress(currencyAddress), contractToApprove.getContractBackend())
 		if err != nil {
 			return err
 		}
@@ -271,13 +322,12 @@ func approveErc20Allowance(
 	price *big.Int,
 	quantity int,
 ) error {
-	provider := contractToApprove.GetProvider()
-	contractAbi, err := abi.NewIERC20(common.HexToAddress(currencyAddress), provider)
+	contractAbi, err := abi.NewIERC20(common.HexToAddress(currencyAddress), contractToApprove.getContractBackend())
 	if err != nil {
 		return err
 	}
 
-	erc20, err := newContractHelper(common.HexToAddress(currencyAddress), provider, contractToApprove.GetRawPrivateKey())
+	erc20, err := newContractHelper(common.HexToAddress(currencyAddress), contractToApprove.ProviderHandler)
 	if err != nil {
 		return err
 	}
@@ -289,7 +339,7 @@ func approveErc20Allowance(
 		return err
 	}
 
-	totalPrice := price.Mul(big.NewInt(int64(quantity)), price)
+	totalPrice := big.NewInt(0).Mul(big.NewInt(int64(quantity)), price)
 
 	if allowance.Cmp(totalPrice) < 0 {
 		txOpts, err := erc20.GetTxOptions(ctx)
@@ -375,17 +425,17 @@ func prepareClaim(
 			if snapshotEntry.Price == "unlimited" || snapshotEntry.Price == "" {
 				priceInProof = MaxUint256
 			} else {
-				flt, err := strconv.ParseFloat(snapshotEntry.Price, 64)
-				if err != nil {
-					return nil, err
-				}
-
 				priceInProof, err = normalizePriceValue(
 					ctx,
-					contractHelper.GetProvider(),
-					flt,
+					contractHelper.getContractBackend(),
+					0,
+					snapshotEntry.Price,
+					nil,
 					snapshotEntry.CurrencyAddress,
 				)
+				if err != nil {
+					return nil, err
+				}
 			}
 
 			if snapshotEntry.CurrencyAddress == "" {
@@ -468,7 +518,7 @@ func fetchSnapshotEntryForAddress(
 func transformResultToClaimCondition(
 	ctx context.Context,
 	pm *abi.IClaimConditionClaimCondition,
-	provider *ethclient.Client,
+	provider currencyReader,
 ) (*ClaimConditionOutput, error) {
 	currencyValue, err := fetchCurrencyValue(ctx, provider, pm.Currency.String(), pm.PricePerToken)
 	if err != nil {
@@ -732,7 +782,7 @@ func handleTokenApproval(
 	}
 
 	if isErc721 {
-		contract, err := abi.NewTokenERC721(common.HexToAddress(assetContract), provider)
+		contract, err := abi.NewTokenERC721(common.HexToAddress(assetContract), helper.getContractBackend())
 		if err != nil {
 			return err
 		}
@@ -768,7 +818,7 @@ func handleTokenApproval(
 			}
 		}
 	} else if isErc1155 {
-		contract, err := abi.NewTokenERC1155(common.HexToAddress(assetContract), provider)
+		contract, err := abi.NewTokenERC1155(common.HexToAddress(assetContract), helper.getContractBackend())
 		if err != nil {
 			return err
 		}
@@ -882,7 +932,7 @@ func mapListing(
 ) (*DirectListing, error) {
 	currencyValue, err := fetchCurrencyValue(
 		ctx,
-		helper.GetProvider(),
+		helper.getContractBackend(),
 		listing.Currency.String(),
 		listing.BuyoutPricePerToken,
 	)
@@ -901,6 +951,175 @@ func mapListing(
 		return nil, err
 	}
 
+	return newDirectListing(listing, currencyValue, asset), nil
+}
+
+// Map many listings at once, batching the reads of the currencies and assets they have in common.
+// The listings whose asset can't be fetched are returned without their asset, along with a
+// *PartialListingsError
+func mapListings(
+	ctx context.Context,
+	helper *contractHelper,
+	storage storage,
+	listings []abi.IMarketplaceListing,
+) ([]*DirectListing, error) {
+	currencyValues := make([]*CurrencyValue, len(listings))
+	currencies := map[common.Address]*Currency{}
+	assetContracts := make([]common.Address, len(listings))
+	tokenIds := make([]*big.Int, len(listings))
+	for i, listing := range listings {
+		currency, ok := currencies[listing.Currency]
+		if !ok {
+			var err error
+			if currency, err = fetchCurrencyMetadata(ctx, helper.getContractBackend(), listing.Currency.String()); err != nil {
+				return nil, err
+			}
+			currencies[listing.Currency] = currency
+		}
+
+		currencyValues[i] = &CurrencyValue{
+			currency.Name,
+			currency.Symbol,
+			currency.Decimals,
+			listing.BuyoutPricePerToken,
+			formatUnits(listing.BuyoutPricePerToken, currency.Decimals),
+			formatUnitsString(listing.BuyoutPricePerToken, currency.Decimals),
+		}
+		assetContracts[i] = listing.AssetContract
+		tokenIds[i] = listing.TokenId
+	}
+
+	assets, errs, err := fetchTokenMetadatasForContracts(ctx, helper, assetContracts, tokenIds, storage)
+	if err != nil {
+		return nil, err
+	}
+
+	directListings := []*DirectListing{}
+	listingIds := []*big.Int{}
+	for i, listing := range listings {
+		directListings = append(directListings, newDirectListing(listing, currencyValues[i], assets[i]))
+		listingIds = append(listingIds, listing.ListingId)
+	}
+
+	return directListings, newPartialListingsError(listingIds, errs)
+}
+
+// Fetch the metadata of tokens of any ERC721 or ERC1155 contracts, batching the detection of the
+// contract standards and the reads of the token URIs. The metadata is fetched from a bounded pool
+// of workers, and a token that can't be fetched gets an error at its index instead of failing the
+// others
+func fetchTokenMetadatasForContracts(
+	ctx context.Context,
+	helper *contractHelper,
+	contractAddresses []common.Address,
+	tokenIds []*big.Int,
+	storage storage,
+) ([]*NFTMetadata, []error, error) {
+	erc165Abi, err := abi.IERC165MetaData.GetAbi()
+	if err != nil {
+		return nil, nil, err
+	}
+	erc721Abi, err := abi.TokenERC721MetaData.GetAbi()
+	if err != nil {
+		return nil, nil, err
+	}
+	erc1155Abi, err := abi.TokenERC1155MetaData.GetAbi()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Check which standard every contract implements
+	contracts := []common.Address{}
+	seen := map[common.Address]bool{}
+	reads := []*contractRead{}
+	for _, address := range contractAddresses {
+		if seen[address] {
+			continue
+		}
+		seen[address] = true
+		contracts = append(contracts, address)
+
+		for _, interfaceId := range [][4]byte{{0x80, 0xAC, 0x58, 0xCD}, {0xD9, 0xB6, 0x7A, 0x26}} {
+			read, err := newContractRead(address, erc165Abi, "supportsInterface", interfaceId)
+			if err != nil {
+				return nil, nil, err
+			}
+			reads = append(reads, read)
+		}
+	}
+
+	isErc721 := map[common.Address]bool{}
+	isErc1155 := map[common.Address]bool{}
+	contractErrs := map[common.Address]error{}
+	results := helper.batchRead(ctx, reads)
+	for i, address := range contracts {
+		supports721, supports1155 := false, false
+		if err := results[2*i].unpackInto(&supports721); err != nil {
+			contractErrs[address] = err
+			continue
+		}
+		if err := results[2*i+1].unpackInto(&supports1155); err != nil {
+			contractErrs[address] = err
+			continue
+		}
+
+		isErc721[address] = supports721
+		isErc1155[address] = supports1155
+	}
+
+	// Read the URIs of all the tokens
+	errs := make([]error, len(contractAddresses))
+	reads = []*contractRead{}
+	readIndexes := make([]int, len(contractAddresses))
+	for i, address := range contractAddresses {
+		readIndexes[i] = -1
+
+		var read *contractRead
+		var err error
+		if contractErrs[address] != nil {
+			errs[i] = contractErrs[address]
+			continue
+		} else if isErc721[address] {
+			read, err = newContractRead(address, erc721Abi, "tokenURI", tokenIds[i])
+		} else if isErc1155[address] {
+			read, err = newContractRead(address, erc1155Abi, "uri", tokenIds[i])
+		} else {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		readIndexes[i] = len(reads)
+		reads = append(reads, read)
+	}
+
+	uris := make([]string, len(contractAddresses))
+	results = helper.batchRead(ctx, reads)
+	for i, index := range readIndexes {
+		if index < 0 {
+			continue
+		}
+		if err := results[index].unpackInto(&uris[i]); err != nil {
+			errs[i] = err
+		}
+	}
+
+	// Fetch the metadata from a bounded pool of workers
+	metadatas := make([]*NFTMetadata, len(contractAddresses))
+	forEachInParallel(ctx, len(contractAddresses), helper.getFetchConcurrency(), func(i int) {
+		if errs[i] == nil {
+			metadatas[i], errs[i] = fetchTokenMetadata(ctx, int(tokenIds[i].Int64()), uris[i], storage)
+		}
+	})
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return metadatas, errs, nil
+}
+
+func newDirectListing(listing abi.IMarketplaceListing, currencyValue *CurrencyValue, asset *NFTMetadata) *DirectListing {
 	return &DirectListing{
 		AssetContractAddress:        listing.AssetContract.String(),
 		BuyoutPrice:                 listing.BuyoutPricePerToken.String(),
@@ -913,5 +1132,5 @@ func mapListing(
 		EndTimeInEpochSeconds:       int(listing.EndTime.Int64()),
 		SellerAddress:               listing.TokenOwner.String(),
 		Asset:                       asset,
-	}, nil
+	}
 }
@@ -0,0 +1,76 @@
+package web3sdks
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseUnitsStringIsExact(t *testing.T) {
+	value, err := parseUnitsString("1.000000000000000001", 18)
+	assert.Nil(t, err)
+	assert.Equal(t, "1000000000000000001", value.String())
+
+	value, err = parseUnitsString("123456789.123456789123456789", 18)
+	assert.Nil(t, err)
+	assert.Equal(t, "123456789123456789123456789", value.String())
+
+	value, err = parseUnitsString("42", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, "42", value.String())
+
+	_, err = parseUnitsString("0.0000001", 6)
+	assert.NotNil(t, err)
+	_, err = parseUnitsString("-1", 18)
+	assert.NotNil(t, err)
+	_, err = parseUnitsString("one", 18)
+	assert.NotNil(t, err)
+}
+
+func TestParseUnitsStringConvertsQuantities(t *testing.T) {
+	value, err := convertQuantityToBigNumber("unlimited", 18)
+	assert.Nil(t, err)
+	assert.Equal(t, new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)), value)
+
+	value, err = convertQuantityToBigNumber("1.000000000000000001", 18)
+	assert.Nil(t, err)
+	assert.Equal(t, "1000000000000000001", value.String())
+
+	// Quantities aren't rounded, and can't be negative
+	_, err = convertQuantityToBigNumber("0.5", 0)
+	assert.NotNil(t, err)
+	_, err = convertQuantityToBigNumber("-1", 0)
+	assert.NotNil(t, err)
+}
+
+func TestParseUnitsUsesShortestFloat(t *testing.T) {
+	value, err := parseUnits(0.1, 18)
+	assert.Nil(t, err)
+	assert.Equal(t, "100000000000000000", value.String())
+
+	value, err = parseUnits(1.1, 18)
+	assert.Nil(t, err)
+	assert.Equal(t, "1100000000000000000", value.String())
+}
+
+func TestNormalizeAmountValuePrecedence(t *testing.T) {
+	value, err := normalizeAmountValue(1, "2", big.NewInt(3), 18)
+	assert.Nil(t, err)
+	assert.Equal(t, "3", value.String())
+
+	value, err = normalizeAmountValue(1, "2", nil, 18)
+	assert.Nil(t, err)
+	assert.Equal(t, "2000000000000000000", value.String())
+
+	value, err = normalizeAmountValue(1, "", nil, 18)
+	assert.Nil(t, err)
+	assert.Equal(t, "1000000000000000000", value.String())
+}
+
+func TestFormatUnitsStringIsExact(t *testing.T) {
+	value, _ := new(big.Int).SetString("1000000000000000001", 10)
+	assert.Equal(t, "1.000000000000000001", formatUnitsString(value, 18))
+	assert.Equal(t, "1.5", formatUnitsString(big.NewInt(1500000), 6))
+	assert.Equal(t, "0", formatUnitsString(big.NewInt(0), 18))
+}
@@ -0,0 +1,95 @@
+package web3sdks
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	gethAbi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// The backend used by the contract bindings of the SDK. Every call goes to the current provider of
+// the handler, and the result of every transaction sent is reported back to the handler. Reverts of
+// calls and gas estimations are decoded into a *RevertError, using the custom errors of contractAbis.
+type contractBackend struct {
+	handler      *ProviderHandler
+	contractAbis []*gethAbi.ABI
+}
+
+var _ bind.ContractBackend = (*contractBackend)(nil)
+
+func (backend *contractBackend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return backend.handler.GetProvider().CodeAt(ctx, contract, blockNumber)
+}
+
+func (backend *contractBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	fetch := func() ([]byte, error) {
+		return backend.handler.GetProvider().CallContract(ctx, call, blockNumber)
+	}
+
+	var result []byte
+	var err error
+	if cache := backend.handler.cache; cache != nil && blockNumber == nil {
+		result, err = cache.call(ctx, backend.handler, call, fetch)
+	} else {
+		result, err = fetch()
+	}
+	if err != nil {
+		return nil, withDecodedRevert(err, backend.contractAbis...)
+	}
+
+	return result, nil
+}
+
+// Get the chain ID of the provider, which is only read once when the read cache is enabled
+func (backend *contractBackend) ChainID(ctx context.Context) (*big.Int, error) {
+	if cache := backend.handler.cache; cache != nil {
+		return cache.getChainId(ctx)
+	}
+
+	return backend.handler.GetProvider().ChainID(ctx)
+}
+
+func (backend *contractBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return backend.handler.GetProvider().HeaderByNumber(ctx, number)
+}
+
+func (backend *contractBackend) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return backend.handler.GetProvider().PendingCodeAt(ctx, account)
+}
+
+func (backend *contractBackend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return backend.handler.GetProvider().PendingNonceAt(ctx, account)
+}
+
+func (backend *contractBackend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return backend.handler.GetProvider().SuggestGasPrice(ctx)
+}
+
+func (backend *contractBackend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return backend.handler.GetProvider().SuggestGasTipCap(ctx)
+}
+
+func (backend *contractBackend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	gas, err := backend.handler.GetProvider().EstimateGas(ctx, call)
+	if err != nil {
+		return 0, withDecodedRevert(err, backend.contractAbis...)
+	}
+
+	return gas, nil
+}
+
+func (backend *contractBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return backend.handler.sendTransaction(ctx, tx)
+}
+
+func (backend *contractBackend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return backend.handler.GetProvider().FilterLogs(ctx, query)
+}
+
+func (backend *contractBackend) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return backend.handler.GetProvider().SubscribeFilterLogs(ctx, query, ch)
+}
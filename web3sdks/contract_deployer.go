@@ -8,7 +8,6 @@ import (
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/mitchellh/mapstructure"
 
 	"github.com/web3sdks/go-sdk/v2/abi"
@@ -42,12 +41,8 @@ type ContractDeployer struct {
 	storage storage
 }
 
-func newContractDeployer(provider *ethclient.Client, privateKey string, storage storage) (*ContractDeployer, error) {
-	handler, err := NewProviderHandler(provider, privateKey)
-	if err != nil {
-		return nil, err
-	}
-
+func newContractDeployer(handler *ProviderHandler, storage storage) (*ContractDeployer, error) {
+	provider := handler.GetProvider()
 	chainId, err := provider.ChainID(context.Background())
 	if err != nil {
 		return nil, err
@@ -58,12 +53,12 @@ func newContractDeployer(provider *ethclient.Client, privateKey string, storage
 		return nil, err
 	}
 
-	helper, err := newContractHelper(common.HexToAddress(factoryAddress), provider, privateKey)
+	helper, err := newContractHelper(common.HexToAddress(factoryAddress), handler)
 	if err != nil {
 		return nil, err
 	}
 
-	factory, err := abi.NewTWFactory(common.HexToAddress(factoryAddress), provider)
+	factory, err := abi.NewTWFactory(common.HexToAddress(factoryAddress), handler.getContractBackend())
 	if err != nil {
 		return nil, err
 	}
@@ -264,17 +259,12 @@ func (deployer *ContractDeployer) deployContract(ctx context.Context, contractTy
 		return "", err
 	}
 
-	receipt, err := deployer.helper.AwaitTx(ctx, tx.Hash())
-	if err != nil {
-		return "", err
-	}
-
-	txReceipt, err := deployer.GetProvider().TransactionReceipt(context.Background(), receipt.Hash())
+	result, err := deployer.helper.AwaitTx(ctx, tx.Hash())
 	if err != nil {
 		return "", err
 	}
 
-	for _, log := range txReceipt.Logs {
+	for _, log := range result.Receipt.Logs {
 		event, err := deployer.factory.ParseProxyDeployed(*log)
 		if err != nil {
 			continue
@@ -27,7 +27,8 @@ func newContractEncoder(contractAbi string, helper *contractHelper) (*ContractEn
 		return nil, err
 	}
 
-	contract := bind.NewBoundContract(helper.getAddress(), parsedAbi, helper.GetProvider(), helper.GetProvider(), helper.GetProvider())
+	backend := helper.getContractBackend(&parsedAbi)
+	contract := bind.NewBoundContract(helper.getAddress(), parsedAbi, backend, backend, backend)
 
 	return &ContractEncoder{
 		abi:      &parsedAbi,
@@ -57,13 +58,60 @@ func newContractEncoder(contractAbi string, helper *contractHelper) (*ContractEn
 //	fmt.Println(tx.Nonce())
 //	fmt.Println(tx.Value())
 func (encoder *ContractEncoder) Encode(ctx context.Context, signerAddress string, method string, args ...interface{}) (*types.Transaction, error) {
+	tx, err := encoder.Prepare(ctx, method, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.From = common.HexToAddress(signerAddress)
+	return tx.Encode(ctx)
+}
+
+// Prepare any contract call on a contract without sending it.
+//
+// method: the name of the contract function to call
+//
+// args: the arguments to pass to the contract function.
+//
+// returns: the prepared transaction for the contract call
+//
+// Example
+//
+//	tx, err := contract.Encoder.Prepare(context.Background(), "transfer", "0x...", 1)
+//	_, err = tx.Simulate(context.Background())
+//	result, err := tx.SendAndWait(context.Background())
+func (encoder *ContractEncoder) Prepare(ctx context.Context, method string, args ...interface{}) (*PreparedTx, error) {
+	_, typedArgs, err := encoder.parseArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := newPreparedTx(encoder.helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return encoder.contract.Transact(opts, method, typedArgs...)
+	})
+	tx.contractAbi = encoder.abi
+
+	return tx, nil
+}
+
+// Get the unsigned transaction built by a contract call for the given signer
+func (encoder *ContractEncoder) encode(ctx context.Context, signerAddress string, build txBuilder) (*types.Transaction, error) {
+	tx := newPreparedTx(encoder.helper, build)
+	tx.From = common.HexToAddress(signerAddress)
+
+	return tx.Encode(ctx)
+}
+
+// Validate the arguments of a contract function and convert them to the types expected by the
+// contract, so users can pass in strings instead of addresses, ints instead of big ints, etc.
+func (encoder *ContractEncoder) parseArgs(method string, args []interface{}) (*abi.Method, []interface{}, error) {
 	abiMethod, exist := encoder.abi.Methods[method]
 	if !exist {
-		return nil, fmt.Errorf("function '%s' not found in contract '%s'", method, encoder.helper.getAddress().String())
+		return nil, nil, fmt.Errorf("function '%s' not found in contract '%s'", method, encoder.helper.getAddress().String())
 	}
 
 	if len(abiMethod.Inputs) != len(args) {
-		return nil, fmt.Errorf(
+		return nil, nil, fmt.Errorf(
 			"function '%s' requires %d arguments, but %d arguments were provided.\nExpected function signature '%s'",
 			method,
 			len(abiMethod.Inputs),
@@ -72,8 +120,6 @@ func (encoder *ContractEncoder) Encode(ctx context.Context, signerAddress string
 		)
 	}
 
-	// Validate argument input types and convert to proper input types for contract
-	// So we can allow users to pass in string intead of address, int instead of big, etc.
 	typedArgs := []interface{}{}
 	for i, arg := range args {
 		input := abiMethod.Inputs[i]
@@ -82,7 +128,7 @@ func (encoder *ContractEncoder) Encode(ctx context.Context, signerAddress string
 		if inputType == "address" {
 			parsedArg, ok := arg.(string)
 			if !ok {
-				return nil, fmt.Errorf(
+				return nil, nil, fmt.Errorf(
 					"argument %d (%v) should be of type 'string', but type '%v' was provided",
 					i,
 					input.Name,
@@ -94,7 +140,7 @@ func (encoder *ContractEncoder) Encode(ctx context.Context, signerAddress string
 		} else if strings.Contains(inputType, "int") {
 			parsedArg, ok := arg.(int)
 			if !ok {
-				return nil, fmt.Errorf(
+				return nil, nil, fmt.Errorf(
 					"argument %d (%v) should be of type 'int', but type '%v' was provided",
 					i,
 					input.Name,
@@ -108,9 +154,5 @@ func (encoder *ContractEncoder) Encode(ctx context.Context, signerAddress string
 		typedArgs = append(typedArgs, arg)
 	}
 
-	txOpts, err := encoder.helper.getUnsignedTxOptions(ctx, signerAddress)
-	if err != nil {
-		return nil, err
-	}
-	return encoder.contract.Transact(txOpts, method, typedArgs...)
+	return &abiMethod, typedArgs, nil
 }
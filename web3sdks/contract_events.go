@@ -2,11 +2,11 @@ package web3sdks
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"math/big"
 	"reflect"
 	"strings"
-	"time"
+	"sync"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -18,6 +18,10 @@ import (
 // This interface provides a way to query past events or listen for future events on any contract.
 // It's currently support on all pre-built and custom contracts!
 //
+// The prebuilt contracts expose their events with typed wrappers, like *MarketplaceEvents or
+// *NFTDropEvents, which embed *ContractEvents, so all of its methods are still available on them.
+// Code that stores contract.Events as a *ContractEvents must use contract.Events.ContractEvents.
+//
 // Example
 //
 //	// First get an instance of your contract
@@ -43,16 +47,40 @@ type EventQueryOptions struct {
 	FromBlock uint64
 	ToBlock   *uint64
 	Filters   map[string]interface{}
+	// Maximum number of blocks queried in a single request, the block range is split in chunks of
+	// this size. Defaults to 0, querying the whole range at once. In both cases, the chunks are
+	// halved for as long as the provider rejects them for returning too many results
+	MaxChunkSize uint64
+	// Order of the events, from the oldest to the most recent by default
+	Order EventOrder
+	// Maximum number of events to get, in the order of the events. Defaults to 0, getting all the
+	// events of the block range
+	Limit int
 }
 
+// Order of the events returned by a query.
+type EventOrder int
+
+const (
+	// From the oldest event to the most recent one
+	EventOrderAscending EventOrder = iota
+	// From the most recent event to the oldest one
+	EventOrderDescending
+)
+
 type ContractEvent struct {
 	EventName   string
 	Data        map[string]interface{}
 	Transaction types.Log
+	// Whether the event was removed from the chain by a reorg after it was delivered to a listener
+	Removed bool
 }
 
 type EventSubscription struct {
-	Err         func() <-chan error
+	// The channel of the errors of the listener, which keeps the most recent errors when nobody
+	// reads them instead of blocking the listener
+	Err func() <-chan error
+	// Stop the listener, which can be called any number of times
 	Unsubscribe func()
 }
 
@@ -73,10 +101,17 @@ func newContractEvents(contractAbi string, helper *contractHelper) (*ContractEve
 
 // Add a listener to listen in the background for any future events of a specific type.
 //
+// When the provider supports subscriptions, like websocket providers, the events are received with
+// a subscription to the logs of the contract, which is automatically set up again if the connection
+// drops, without missing the events emitted in the meantime. Otherwise, like for HTTP providers,
+// the listener polls for new events.
+//
 // eventName: The name of the event to listen for
 //
 // listener: The listener function that will be called whenever a new event is received
 //
+// options: Optional options of the listener, like the poll interval or the block to start listening from
+//
 // returns: An EventSubscription object that can be used to unsubscribe from the event or check for errors
 //
 // Example
@@ -89,49 +124,101 @@ func newContractEvents(contractAbi string, helper *contractHelper) (*ContractEve
 //	// Add a new listener for the Transfer event
 //	subscription := contract.Events.AddEventListener(context.Background(), "Transfer", listener)
 //
+//	// Or backfill the Transfer events from a known block before listening for the new ones
+//	fromBlock := uint64(100000000)
+//	subscription = contract.Events.AddEventListener(context.Background(), "Transfer", listener, &web3sdks.EventListenerOptions{
+//	  FromBlock: &fromBlock,
+//	})
+//
 //	// Unsubscribe from the Transfer event at some time in the future, closing the listener
 //	subscription.Unsubscribe()
-func (events *ContractEvents) AddEventListener(ctx context.Context, eventName string, listener func(event ContractEvent)) EventSubscription {
-	ticker := time.NewTicker(2 * time.Second)
-	done := make(chan bool)
-	errors := make(chan error)
+func (events *ContractEvents) AddEventListener(
+	ctx context.Context,
+	eventName string,
+	listener func(event ContractEvent),
+	options ...*EventListenerOptions,
+) EventSubscription {
+	getQuery := func() (ethereum.FilterQuery, error) {
+		return events.getEventQuery(eventName, nil)
+	}
+	handle := func(log types.Log) error {
+		event, err := events.transformEvent(eventName, log)
+		if err != nil {
+			return err
+		}
 
-	go func() {
-		nextBlockToCheck, err := events.helper.GetProvider().BlockNumber(ctx)
+		listener(event)
+		return nil
+	}
+
+	return events.addListener(ctx, getQuery, handle, options)
+}
+
+// Add a listener to listen in the background for any future events of the contract, or of a
+// subset of its events, with the same options as AddEventListener.
+//
+// eventNames: The names of the events to listen for, or nil to listen for all the events of the contract
+//
+// listener: The listener function that will be called whenever a new event is received
+//
+// options: Optional options of the listener, like the poll interval or the block to start listening from
+//
+// returns: An EventSubscription object that can be used to unsubscribe from the events or check
+// for errors. The logs that can't be decoded with the events of the contract ABI are sent to the
+// error channel as a *LogError instead of being delivered
+//
+// Example
+//
+//	listener := func (event web3sdks.ContractEvent) {
+//	  fmt.Printf("%s: %#v\n", event.EventName, event.Data)
+//	}
+//
+//	// Listen for all the events of the contract
+//	subscription := contract.Events.AddAllEventsListener(context.Background(), nil, listener)
+//
+//	// Or only for some of them
+//	subscription = contract.Events.AddAllEventsListener(context.Background(), []string{"Transfer", "TokensMinted"}, listener)
+func (events *ContractEvents) AddAllEventsListener(
+	ctx context.Context,
+	eventNames []string,
+	listener func(event ContractEvent),
+	options ...*EventListenerOptions,
+) EventSubscription {
+	getQuery := func() (ethereum.FilterQuery, error) {
+		return events.getEventsQuery(eventNames)
+	}
+
+	handle := func(log types.Log) error {
+		event, err := events.decodeLog(log)
 		if err != nil {
-			errors <- err
-			return
+			return err
 		}
 
-		for {
-			select {
-			case <-done:
-				return
-			case <-ticker.C:
-				currentBlockNumber, err := events.helper.GetProvider().BlockNumber(ctx)
-				if err != nil {
-					errors <- err
-					continue
-				}
+		listener(event)
+		return nil
+	}
 
-				if currentBlockNumber >= nextBlockToCheck {
-					recentEvents, err := events.GetEvents(ctx, eventName, EventQueryOptions{
-						FromBlock: nextBlockToCheck,
-						ToBlock:   &currentBlockNumber,
-					})
-					if err != nil {
-						errors <- err
-						continue
-					}
+	return events.addListener(ctx, getQuery, handle, options)
+}
 
-					for _, event := range recentEvents {
-						listener(event)
-					}
+func (events *ContractEvents) addListener(
+	ctx context.Context,
+	getQuery func() (ethereum.FilterQuery, error),
+	handle func(log types.Log) error,
+	options []*EventListenerOptions,
+) EventSubscription {
+	done := make(chan bool)
+	errors := make(chan error, eventErrorBufferSize)
+	once := sync.Once{}
 
-					nextBlockToCheck = currentBlockNumber + 1
-				}
-			}
+	go func() {
+		query, err := getQuery()
+		if err != nil {
+			sendEventError(errors, err)
+			return
 		}
+
+		newEventListener(events, query, handle, options, done, errors).run(ctx)
 	}()
 
 	subscription := EventSubscription{
@@ -139,7 +226,9 @@ func (events *ContractEvents) AddEventListener(ctx context.Context, eventName st
 			return errors
 		},
 		Unsubscribe: func() {
-			done <- true
+			once.Do(func() {
+				close(done)
+			})
 		},
 	}
 
@@ -171,22 +260,120 @@ func (events *ContractEvents) AddEventListener(ctx context.Context, eventName st
 //
 //	// Now we can query for the Transfer events
 //	events, _ := contract.Events.GetEvents("Transfer", queryOptions)
+//
+//	// Or get the 100 most recent Transfer events, 5000 blocks at a time
+//	events, _ = contract.Events.GetEvents("Transfer", web3sdks.EventQueryOptions{
+//	  MaxChunkSize: 5000,
+//	  Order:        web3sdks.EventOrderDescending,
+//	  Limit:        100,
+//	})
 func (events *ContractEvents) GetEvents(ctx context.Context, eventName string, options EventQueryOptions) ([]ContractEvent, error) {
+	config, err := events.getEventQuery(eventName, options.Filters)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := events.filterLogs(ctx, config, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return events.transformEvents(eventName, logs)
+}
+
+// Query past events of all the types of the contract, or of a subset of its types.
+//
+// eventNames: The names of the events to query for, or nil to query for all the events of the contract
+//
+// options: The options to use when querying for events, like for GetEvents. Filters aren't supported
+// since the events have different arguments, and an error is returned when they're set
+//
+// returns: a list of ContractEvent objects of all the types, in the order of the options. If some
+// logs can't be decoded with the events of the contract ABI, the decoded events are returned along
+// with an *UndecodedLogsError
+//
+// Example
+//
+//	// Get all the events of the contract from a specific block range
+//	events, err := contract.Events.GetAllEvents(context.Background(), nil, web3sdks.EventQueryOptions{
+//	  FromBlock: 100000000,
+//	})
+//
+//	// Or only the Transfer and TokensMinted events
+//	events, err = contract.Events.GetAllEvents(context.Background(), []string{"Transfer", "TokensMinted"}, queryOptions)
+func (events *ContractEvents) GetAllEvents(ctx context.Context, eventNames []string, options EventQueryOptions) ([]ContractEvent, error) {
+	if len(options.Filters) > 0 {
+		return nil, errors.New("Filters aren't supported when querying the events of several types, use GetEvents instead")
+	}
+
+	config, err := events.getEventsQuery(eventNames)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := events.filterLogs(ctx, config, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return events.decodeLogs(logs)
+}
+
+// Query the logs of an event matching the options, and pass them to the parse function in the
+// order of the options. Used by the typed events of the prebuilt contracts to decode the logs with
+// their generated bindings
+func (events *ContractEvents) queryLogs(ctx context.Context, eventName string, options EventQueryOptions, parse func(log types.Log) error) error {
+	config, err := events.getEventQuery(eventName, options.Filters)
+	if err != nil {
+		return err
+	}
+
+	logs, err := events.filterLogs(ctx, config, options)
+	if err != nil {
+		return err
+	}
+
+	for _, log := range logs {
+		if err := parse(log); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Listen for the logs of an event like AddEventListener, passing them to the handle function
+// instead of decoding them into a ContractEvent
+func (events *ContractEvents) listenLogs(
+	ctx context.Context,
+	eventName string,
+	handle func(log types.Log) error,
+	options []*EventListenerOptions,
+) EventSubscription {
+	getQuery := func() (ethereum.FilterQuery, error) {
+		return events.getEventQuery(eventName, nil)
+	}
+
+	return events.addListener(ctx, getQuery, handle, options)
+}
+
+// Get the query of the logs of an event matching the filters, without any block range
+func (events *ContractEvents) getEventQuery(eventName string, filters map[string]interface{}) (ethereum.FilterQuery, error) {
 	eventSignature, ok := events.abi.Events[eventName]
 	if !ok {
-		return nil, fmt.Errorf("Event with name '%s' not found", eventName)
+		return ethereum.FilterQuery{}, fmt.Errorf("Event with name '%s' not found", eventName)
 	}
 
 	query := [][]interface{}{{eventSignature.ID}}
-	if options.Filters != nil {
+	if filters != nil {
 		// args := []interface{}{}
 		for _, input := range eventSignature.Inputs {
 			// For all indexed inputs, check if a filter is provided
 			if input.Indexed {
-				if value, ok := options.Filters[input.Name]; ok {
+				if value, ok := filters[input.Name]; ok {
 					// If a filter is provided, check if the type is correct
 					if reflect.TypeOf(value) != input.Type.GetType() {
-						return nil, fmt.Errorf(
+						return ethereum.FilterQuery{}, fmt.Errorf(
 							"Filter for indexed input '%s' is of wrong type, expected type '%s'",
 							input.Name,
 							input.Type.GetType().String(),
@@ -205,23 +392,88 @@ func (events *ContractEvents) GetEvents(ctx context.Context, eventName string, o
 
 	topics, err := abi.MakeTopics(query...)
 	if err != nil {
-		return nil, err
+		return ethereum.FilterQuery{}, err
 	}
 
-	config := ethereum.FilterQuery{
+	return ethereum.FilterQuery{
 		Addresses: []common.Address{events.helper.getAddress()},
 		Topics:    topics,
-		FromBlock: new(big.Int).SetUint64(options.FromBlock),
+	}, nil
+}
+
+// Get the query of the logs of any of the events, or of all the events of the ABI if there are no
+// event names. Anonymous events can't be queried since their logs don't have the event ID topic
+func (events *ContractEvents) getEventsQuery(eventNames []string) (ethereum.FilterQuery, error) {
+	eventIds := []common.Hash{}
+	if len(eventNames) == 0 {
+		for _, event := range events.abi.Events {
+			if !event.Anonymous {
+				eventIds = append(eventIds, event.ID)
+			}
+		}
+	}
+
+	for _, eventName := range eventNames {
+		event, ok := events.abi.Events[eventName]
+		if !ok {
+			return ethereum.FilterQuery{}, fmt.Errorf("Event with name '%s' not found", eventName)
+		}
+		if event.Anonymous {
+			return ethereum.FilterQuery{}, fmt.Errorf("Anonymous event '%s' can't be queried", eventName)
+		}
+
+		eventIds = append(eventIds, event.ID)
+	}
+
+	return ethereum.FilterQuery{
+		Addresses: []common.Address{events.helper.getAddress()},
+		Topics:    [][]common.Hash{eventIds},
+	}, nil
+}
+
+// Decode logs of any event of the contract, returning the decoded events along with an
+// *UndecodedLogsError if some of the logs can't be decoded
+func (events *ContractEvents) decodeLogs(logs []types.Log) ([]ContractEvent, error) {
+	parsedLogs := []ContractEvent{}
+	undecodedErr := &UndecodedLogsError{}
+	for _, log := range logs {
+		event, err := events.decodeLog(log)
+		if err != nil {
+			undecodedErr.LogErrors = append(undecodedErr.LogErrors, err.(*LogError))
+			continue
+		}
+
+		parsedLogs = append(parsedLogs, event)
+	}
+
+	if len(undecodedErr.LogErrors) > 0 {
+		return parsedLogs, undecodedErr
+	}
+
+	return parsedLogs, nil
+}
+
+// Decode a log with the event of the ABI matching its ID topic, returning a *LogError if it can't
+// be decoded
+func (events *ContractEvents) decodeLog(log types.Log) (ContractEvent, error) {
+	if len(log.Topics) == 0 {
+		return ContractEvent{}, &LogError{Log: log, Err: errors.New("Log has no event ID topic")}
 	}
-	if options.ToBlock != nil {
-		config.ToBlock = new(big.Int).SetUint64(*options.ToBlock)
+
+	event, err := events.abi.EventByID(log.Topics[0])
+	if err != nil {
+		return ContractEvent{}, &LogError{Log: log, Err: err}
 	}
 
-	logs, err := events.helper.GetProvider().FilterLogs(ctx, config)
+	parsedLog, err := events.transformEvent(event.Name, log)
 	if err != nil {
-		return nil, err
+		return ContractEvent{}, &LogError{Log: log, Err: err}
 	}
 
+	return parsedLog, nil
+}
+
+func (events *ContractEvents) transformEvents(eventName string, logs []types.Log) ([]ContractEvent, error) {
 	parsedLogs := []ContractEvent{}
 	for _, log := range logs {
 		event, err := events.transformEvent(eventName, log)
@@ -240,7 +492,7 @@ func (events *ContractEvents) transformEvent(eventName string, log types.Log) (C
 	if err := events.contract.UnpackLogIntoMap(parsedLog, eventName, log); err != nil {
 		return ContractEvent{}, err
 	}
-	event := ContractEvent{eventName, parsedLog, log}
+	event := ContractEvent{EventName: eventName, Data: parsedLog, Transaction: log, Removed: log.Removed}
 
 	return event, nil
 }
@@ -2,15 +2,11 @@ package web3sdks
 
 import (
 	"context"
-	"fmt"
-	"log"
-	"math/big"
-	"time"
+	"errors"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
 )
 
 type contractHelper struct {
@@ -18,21 +14,16 @@ type contractHelper struct {
 	*ProviderHandler
 }
 
-const (
-	txWaitTimeBetweenAttempts = time.Second * 1
-	txMaxAttempts             = 20
-)
+func newContractHelper(address common.Address, handler *ProviderHandler) (*contractHelper, error) {
+	if handler == nil {
+		return nil, errors.New("Failed to create contract helper, missing provider handler")
+	}
 
-func newContractHelper(address common.Address, provider *ethclient.Client, privateKey string) (*contractHelper, error) {
-	if handler, err := NewProviderHandler(provider, privateKey); err != nil {
-		return nil, err
-	} else {
-		helper := &contractHelper{
-			address,
-			handler,
-		}
-		return helper, nil
+	helper := &contractHelper{
+		address,
+		handler,
 	}
+	return helper, nil
 }
 
 func (helper *contractHelper) getAddress() common.Address {
@@ -40,26 +31,22 @@ func (helper *contractHelper) getAddress() common.Address {
 }
 
 func (helper *contractHelper) getUnsignedTxOptions(ctx context.Context, signerAddress string) (*bind.TransactOpts, error) {
-	var tipCap, feeCap *big.Int
-
-	provider := helper.GetProvider()
-	block, err := provider.BlockByNumber(ctx, nil)
-	if err == nil && block.BaseFee() != nil {
-		tipCap, _ = big.NewInt(0).SetString("2500000000", 10)
-		baseFee := big.NewInt(0).Mul(block.BaseFee(), big.NewInt(2))
-		feeCap = big.NewInt(0).Add(baseFee, tipCap)
+	fees, err := helper.getGasFees(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	txOpts := &bind.TransactOpts{
 		Context:   ctx,
 		NoSend:    true,
 		From:      common.HexToAddress(signerAddress),
-		GasTipCap: tipCap,
-		GasFeeCap: feeCap,
+		GasPrice:  fees.GasPrice,
+		GasTipCap: fees.GasTipCap,
+		GasFeeCap: fees.GasFeeCap,
 	}
 
 	txOpts.Signer = func(address common.Address, transaction *types.Transaction) (*types.Transaction, error) {
-		return transaction, nil
+		return helper.prepareTx(ctx, address, transaction, nil)
 	}
 
 	return txOpts, nil
@@ -74,63 +61,32 @@ func (helper *contractHelper) GetTxOptions(ctx context.Context) (*bind.TransactO
 }
 
 func (helper *contractHelper) getRawTxOptions(ctx context.Context, noSend bool) (*bind.TransactOpts, error) {
-	if helper.GetRawPrivateKey() == "" {
-		return nil, fmt.Errorf("You need to set a private key to use this function!")
+	if helper.GetSigner() == nil {
+		return nil, ErrNoSigner
 	}
 
-	var tipCap, feeCap *big.Int
-
-	provider := helper.GetProvider()
-	block, err := provider.BlockByNumber(ctx, nil)
-	if err == nil && block.BaseFee() != nil {
-		tipCap, _ = big.NewInt(0).SetString("2500000000", 10)
-		baseFee := big.NewInt(0).Mul(block.BaseFee(), big.NewInt(2))
-		feeCap = big.NewInt(0).Add(baseFee, tipCap)
+	fees, err := helper.getGasFees(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	signer, err := helper.getSigner(ctx)
 	if err != nil {
 		return nil, err
 	}
+	// Encoded transactions are never sent, so they don't need a nonce from the nonce manager
+	if !noSend {
+		signer = helper.withManagedNonce(ctx, signer)
+	}
 	txOpts := &bind.TransactOpts{
 		Context:   ctx,
 		NoSend:    noSend,
 		From:      helper.GetSignerAddress(),
 		Signer:    signer,
-		GasTipCap: tipCap,
-		GasFeeCap: feeCap,
+		GasPrice:  fees.GasPrice,
+		GasTipCap: fees.GasTipCap,
+		GasFeeCap: fees.GasFeeCap,
 	}
 
 	return txOpts, nil
 }
-
-func (helper *contractHelper) AwaitTx(ctx context.Context, hash common.Hash) (*types.Transaction, error) {
-	provider := helper.GetProvider()
-	wait := txWaitTimeBetweenAttempts
-	maxAttempts := uint8(txMaxAttempts)
-	attempts := uint8(0)
-
-	var syncError error
-	for {
-		if attempts >= maxAttempts {
-			fmt.Println("Retry attempts to get tx exhausted, tx might have failed")
-			return nil, syncError
-		}
-
-		if tx, isPending, err := provider.TransactionByHash(ctx, hash); err != nil {
-			syncError = err
-			log.Printf("Failed to get tx %v, err = %v\n", hash.String(), err)
-			attempts += 1
-			time.Sleep(wait)
-			continue
-		} else {
-			if isPending {
-				log.Println("Transaction still pending...")
-				time.Sleep(wait)
-				continue
-			}
-			log.Printf("Transaction with hash %v mined successfully\n", tx.Hash())
-			return tx, nil
-		}
-	}
-}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
@@ -30,22 +31,22 @@ type Edition struct {
 	Helper    *contractHelper
 	Signature *ERC1155SignatureMinting
 	Encoder   *ContractEncoder
-	Events    *ContractEvents
+	Events    *EditionEvents
 }
 
-func newEdition(provider *ethclient.Client, address common.Address, privateKey string, storage storage) (*Edition, error) {
-	if contractAbi, err := abi.NewTokenERC1155(address, provider); err != nil {
+func newEdition(provider *ethclient.Client, address common.Address, handler *ProviderHandler, storage storage) (*Edition, error) {
+	if contractAbi, err := abi.NewTokenERC1155(address, handler.getContractBackend()); err != nil {
 		return nil, err
 	} else {
-		if helper, err := newContractHelper(address, provider, privateKey); err != nil {
+		if helper, err := newContractHelper(address, handler); err != nil {
 			return nil, err
 		} else {
-			erc1155, err := newERC1155(provider, address, privateKey, storage)
+			erc1155, err := newERC1155(provider, address, handler, storage)
 			if err != nil {
 				return nil, err
 			}
 
-			signature, err := newERC1155SignatureMinting(provider, address, privateKey, storage)
+			signature, err := newERC1155SignatureMinting(provider, address, handler, storage)
 			if err != nil {
 				return nil, err
 			}
@@ -55,7 +56,7 @@ func newEdition(provider *ethclient.Client, address common.Address, privateKey s
 				return nil, err
 			}
 
-			events, err := newContractEvents(abi.TokenERC1155ABI, helper)
+			events, err := newEditionEvents(contractAbi, helper)
 			if err != nil {
 				return nil, err
 			}
@@ -78,11 +79,21 @@ func newEdition(provider *ethclient.Client, address common.Address, privateKey s
 // metadataWithSupply: nft metadata with supply of the NFT to mint
 //
 // returns: the transaction receipt of the mint
-func (edition *Edition) Mint(ctx context.Context, metadataWithSupply *EditionMetadataInput) (*types.Transaction, error) {
+func (edition *Edition) Mint(ctx context.Context, metadataWithSupply *EditionMetadataInput) (*TransactionResult, error) {
 	address := edition.Helper.GetSignerAddress().String()
 	return edition.MintTo(ctx, address, metadataWithSupply)
 }
 
+// Prepare the mint of an NFT to the connected wallet without sending it.
+//
+// metadataWithSupply: nft metadata with supply of the NFT to mint
+//
+// returns: the prepared mint transaction
+func (edition *Edition) PrepareMint(ctx context.Context, metadataWithSupply *EditionMetadataInput) (*PreparedTx, error) {
+	address := edition.Helper.GetSignerAddress().String()
+	return edition.PrepareMintTo(ctx, address, metadataWithSupply)
+}
+
 // Mint a new NFT to the specified wallet.
 //
 // address: the wallet address to mint the NFT to
@@ -107,29 +118,44 @@ func (edition *Edition) Mint(ctx context.Context, metadataWithSupply *EditionMet
 //		}
 //
 //		tx, err := contract.MintTo(context.Background(), "{{wallet_address}}", metadataWithSupply)
-func (edition *Edition) MintTo(ctx context.Context, address string, metadataWithSupply *EditionMetadataInput) (*types.Transaction, error) {
-	uri, err := uploadOrExtractUri(ctx, metadataWithSupply.Metadata, edition.storage)
+func (edition *Edition) MintTo(ctx context.Context, address string, metadataWithSupply *EditionMetadataInput) (*TransactionResult, error) {
+	tx, err := edition.PrepareMintTo(ctx, address, metadataWithSupply)
 	if err != nil {
 		return nil, err
 	}
 
-	MaxUint256 := new(big.Int).Sub(new(big.Int).Lsh(common.Big1, 256), common.Big1)
-	txOpts, err := edition.Helper.GetTxOptions(ctx)
-	if err != nil {
-		return nil, err
-	}
-	tx, err := edition.abi.MintTo(
-		txOpts,
-		common.HexToAddress(address),
-		MaxUint256,
-		uri,
-		big.NewInt(int64(metadataWithSupply.Supply)),
-	)
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the mint of a new NFT to the specified wallet without sending it. The metadata is
+// uploaded when preparing the transaction.
+//
+// address: the wallet address to mint the NFT to
+//
+// metadataWithSupply: nft metadata with supply of the NFT to mint
+//
+// returns: the prepared mint transaction
+//
+// Example
+//
+//	tx, err := contract.PrepareMintTo(context.Background(), "{{wallet_address}}", metadataWithSupply)
+//	estimate, err := tx.Estimate(context.Background())
+func (edition *Edition) PrepareMintTo(ctx context.Context, address string, metadataWithSupply *EditionMetadataInput) (*PreparedTx, error) {
+	uri, err := uploadOrExtractUri(ctx, metadataWithSupply.Metadata, edition.storage)
 	if err != nil {
 		return nil, err
 	}
 
-	return edition.Helper.AwaitTx(ctx, tx.Hash())
+	MaxUint256 := new(big.Int).Sub(new(big.Int).Lsh(common.Big1, 256), common.Big1)
+	return newPreparedTx(edition.Helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return edition.abi.MintTo(
+			opts,
+			common.HexToAddress(address),
+			MaxUint256,
+			uri,
+			big.NewInt(int64(metadataWithSupply.Supply)),
+		)
+	}), nil
 }
 
 // Mint additionaly supply of a token to the connected wallet.
@@ -139,11 +165,23 @@ func (edition *Edition) MintTo(ctx context.Context, address string, metadataWith
 // additionalSupply: additional supply to mint
 //
 // returns: the transaction receipt of the mint
-func (edition *Edition) MintAdditionalSupply(ctx context.Context, tokenId int, additionalSupply int) (*types.Transaction, error) {
+func (edition *Edition) MintAdditionalSupply(ctx context.Context, tokenId int, additionalSupply int) (*TransactionResult, error) {
 	address := edition.Helper.GetSignerAddress().String()
 	return edition.MintAdditionalSupplyTo(ctx, address, tokenId, additionalSupply)
 }
 
+// Prepare the mint of additional supply of a token to the connected wallet without sending it.
+//
+// tokenId: token ID to mint additional supply of
+//
+// additionalSupply: additional supply to mint
+//
+// returns: the prepared mint transaction
+func (edition *Edition) PrepareMintAdditionalSupply(ctx context.Context, tokenId int, additionalSupply int) (*PreparedTx, error) {
+	address := edition.Helper.GetSignerAddress().String()
+	return edition.PrepareMintAdditionalSupplyTo(ctx, address, tokenId, additionalSupply)
+}
+
 // Mint additional supply of a token to the specified wallet.
 //
 // to: address of the wallet to mint NFTs to
@@ -153,28 +191,39 @@ func (edition *Edition) MintAdditionalSupply(ctx context.Context, tokenId int, a
 // additionalySupply: additional supply to mint
 //
 // returns: the transaction receipt of the mint
-func (edition *Edition) MintAdditionalSupplyTo(ctx context.Context, to string, tokenId int, additionalSupply int) (*types.Transaction, error) {
-	metadata, err := edition.getTokenMetadata(ctx, tokenId)
+func (edition *Edition) MintAdditionalSupplyTo(ctx context.Context, to string, tokenId int, additionalSupply int) (*TransactionResult, error) {
+	tx, err := edition.PrepareMintAdditionalSupplyTo(ctx, to, tokenId, additionalSupply)
 	if err != nil {
 		return nil, err
 	}
 
-	txOpts, err := edition.Helper.GetTxOptions(ctx)
-	if err != nil {
-		return nil, err
-	}
-	tx, err := edition.abi.MintTo(
-		txOpts,
-		common.HexToAddress(to),
-		big.NewInt(int64(tokenId)),
-		metadata.Uri,
-		big.NewInt(int64(additionalSupply)),
-	)
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the mint of additional supply of a token to the specified wallet without sending it.
+//
+// to: address of the wallet to mint NFTs to
+//
+// tokenId: token Id to mint additional supply of
+//
+// additionalySupply: additional supply to mint
+//
+// returns: the prepared mint transaction
+func (edition *Edition) PrepareMintAdditionalSupplyTo(ctx context.Context, to string, tokenId int, additionalSupply int) (*PreparedTx, error) {
+	metadata, err := edition.getTokenMetadata(ctx, tokenId)
 	if err != nil {
 		return nil, err
 	}
 
-	return edition.Helper.AwaitTx(ctx, tx.Hash())
+	return newPreparedTx(edition.Helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return edition.abi.MintTo(
+			opts,
+			common.HexToAddress(to),
+			big.NewInt(int64(tokenId)),
+			metadata.Uri,
+			big.NewInt(int64(additionalSupply)),
+		)
+	}), nil
 }
 
 // Mint a batch of NFTs to the connected wallet.
@@ -182,10 +231,19 @@ func (edition *Edition) MintAdditionalSupplyTo(ctx context.Context, to string, t
 // metadatasWithSupply: list of NFT metadatas with supplies to mint
 //
 // returns: the transaction receipt of the mint
-func (edition *Edition) MintBatch(ctx context.Context, metadatasWithSupply []*EditionMetadataInput) (*types.Transaction, error) {
+func (edition *Edition) MintBatch(ctx context.Context, metadatasWithSupply []*EditionMetadataInput) (*TransactionResult, error) {
 	return edition.MintBatchTo(ctx, edition.Helper.GetSignerAddress().String(), metadatasWithSupply)
 }
 
+// Prepare the mint of a batch of NFTs to the connected wallet without sending it.
+//
+// metadatasWithSupply: list of NFT metadatas with supplies to mint
+//
+// returns: the prepared mint transaction
+func (edition *Edition) PrepareMintBatch(ctx context.Context, metadatasWithSupply []*EditionMetadataInput) (*PreparedTx, error) {
+	return edition.PrepareMintBatchTo(ctx, edition.Helper.GetSignerAddress().String(), metadatasWithSupply)
+}
+
 // Mint a batch of NFTs to a specific wallet.
 //
 // to: address of the wallet to mint NFTs to
@@ -214,7 +272,24 @@ func (edition *Edition) MintBatch(ctx context.Context, metadatasWithSupply []*Ed
 //	}
 //
 //	tx, err := contract.MintBatchTo(context.Background(), "{{wallet_address}}", metadatasWithSupply)
-func (edition *Edition) MintBatchTo(ctx context.Context, to string, metadatasWithSupply []*EditionMetadataInput) (*types.Transaction, error) {
+func (edition *Edition) MintBatchTo(ctx context.Context, to string, metadatasWithSupply []*EditionMetadataInput) (*TransactionResult, error) {
+	tx, err := edition.PrepareMintBatchTo(ctx, to, metadatasWithSupply)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the mint of a batch of NFTs to a specific wallet without sending it. The metadatas
+// are uploaded when preparing the transaction.
+//
+// to: address of the wallet to mint NFTs to
+//
+// metadatasWithSupply: list of NFT metadatas with supplies to mint
+//
+// returns: the prepared mint transaction
+func (edition *Edition) PrepareMintBatchTo(ctx context.Context, to string, metadatasWithSupply []*EditionMetadataInput) (*PreparedTx, error) {
 	metadatas := []*NFTMetadataInput{}
 	for _, metadataWithSupply := range metadatasWithSupply {
 		metadatas = append(metadatas, metadataWithSupply.Metadata)
@@ -233,12 +308,9 @@ func (edition *Edition) MintBatchTo(ctx context.Context, to string, metadatasWit
 	encoded := [][]byte{}
 	MaxUint256 := new(big.Int).Sub(new(big.Int).Lsh(common.Big1, 256), common.Big1)
 	for index, uri := range uris {
-		txOpts, err := edition.Helper.getEncodedTxOptions(ctx)
-		if err != nil {
-			return nil, err
-		}
-		tx, err := edition.abi.MintTo(
-			txOpts,
+		data, err := encodeCallData(
+			abi.TokenERC1155MetaData,
+			"mintTo",
 			common.HexToAddress(to),
 			MaxUint256,
 			uri,
@@ -248,17 +320,10 @@ func (edition *Edition) MintBatchTo(ctx context.Context, to string, metadatasWit
 			return nil, err
 		}
 
-		encoded = append(encoded, tx.Data())
-	}
-
-	txOpts, err := edition.Helper.GetTxOptions(ctx)
-	if err != nil {
-		return nil, err
-	}
-	tx, err := edition.abi.Multicall(txOpts, encoded)
-	if err != nil {
-		return nil, err
+		encoded = append(encoded, data)
 	}
 
-	return edition.Helper.AwaitTx(ctx, tx.Hash())
+	return newPreparedTx(edition.Helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return edition.abi.Multicall(opts, encoded)
+	}), nil
 }
@@ -32,17 +32,17 @@ type EditionDrop struct {
 	Helper          *contractHelper
 	ClaimConditions *EditionDropClaimConditions
 	Encoder         *ContractEncoder
-	Events          *ContractEvents
+	Events          *EditionDropEvents
 }
 
-func newEditionDrop(provider *ethclient.Client, address common.Address, privateKey string, storage storage) (*EditionDrop, error) {
-	if contractAbi, err := abi.NewDropERC1155(address, provider); err != nil {
+func newEditionDrop(provider *ethclient.Client, address common.Address, handler *ProviderHandler, storage storage) (*EditionDrop, error) {
+	if contractAbi, err := abi.NewDropERC1155(address, handler.getContractBackend()); err != nil {
 		return nil, err
 	} else {
-		if helper, err := newContractHelper(address, provider, privateKey); err != nil {
+		if helper, err := newContractHelper(address, handler); err != nil {
 			return nil, err
 		} else {
-			if erc1155, err := newERC1155(provider, address, privateKey, storage); err != nil {
+			if erc1155, err := newERC1155(provider, address, handler, storage); err != nil {
 				return nil, err
 			} else {
 				claimConditions, err := newEditionDropClaimConditions(address, provider, helper, storage)
@@ -55,7 +55,7 @@ func newEditionDrop(provider *ethclient.Client, address common.Address, privateK
 					return nil, err
 				}
 
-				events, err := newContractEvents(abi.DropERC1155ABI, helper)
+				events, err := newEditionDropEvents(contractAbi, helper)
 				if err != nil {
 					return nil, err
 				}
@@ -108,7 +108,22 @@ func newEditionDrop(provider *ethclient.Client, address common.Address, privateK
 //	}
 //
 //	tx, err := contract.MintBatchTo(context.Background(), "{{wallet_address}}", metadatasWithSupply)
-func (drop *EditionDrop) CreateBatch(ctx context.Context, metadatas []*NFTMetadataInput) (*types.Transaction, error) {
+func (drop *EditionDrop) CreateBatch(ctx context.Context, metadatas []*NFTMetadataInput) (*TransactionResult, error) {
+	tx, err := drop.PrepareCreateBatch(ctx, metadatas)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the lazy mint of a batch of NFTs without sending it. The metadatas are uploaded when
+// preparing the transaction.
+//
+// metadatas: list of NFT metadatas to create
+//
+// returns: the prepared transaction of the batch creation
+func (drop *EditionDrop) PrepareCreateBatch(ctx context.Context, metadatas []*NFTMetadataInput) (*PreparedTx, error) {
 	startNumber, err := drop.abi.NextTokenIdToMint(&bind.CallOpts{Context: ctx})
 	if err != nil {
 		return nil, err
@@ -134,22 +149,18 @@ func (drop *EditionDrop) CreateBatch(ctx context.Context, metadatas []*NFTMetada
 		contractAddress,
 		signerAddress,
 	)
-
-	txOpts, err := drop.Helper.GetTxOptions(ctx)
-	if err != nil {
-		return nil, err
-	}
-	tx, err := drop.abi.LazyMint(
-		txOpts,
-		big.NewInt(int64(len(batch.uris))),
-		batch.baseUri,
-		[]byte{},
-	)
 	if err != nil {
 		return nil, err
 	}
 
-	return drop.Helper.AwaitTx(ctx, tx.Hash())
+	return newPreparedTx(drop.Helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return drop.abi.LazyMint(
+			opts,
+			big.NewInt(int64(len(batch.uris))),
+			batch.baseUri,
+			[]byte{},
+		)
+	}), nil
 }
 
 // Claim NFTs from this contract to the connect wallet.
@@ -159,11 +170,23 @@ func (drop *EditionDrop) CreateBatch(ctx context.Context, metadatas []*NFTMetada
 // quantity: the number of NFTs to claim
 //
 // returns: the transaction receipt of the claim
-func (drop *EditionDrop) Claim(ctx context.Context, tokenId int, quantity int) (*types.Transaction, error) {
+func (drop *EditionDrop) Claim(ctx context.Context, tokenId int, quantity int) (*TransactionResult, error) {
 	address := drop.Helper.GetSignerAddress().String()
 	return drop.ClaimTo(ctx, address, tokenId, quantity)
 }
 
+// Prepare a claim of NFTs from this contract to the connected wallet without sending it.
+//
+// tokenId: the token ID of the NFT to claim
+//
+// quantity: the number of NFTs to claim
+//
+// returns: the prepared claim transaction
+func (drop *EditionDrop) PrepareClaim(ctx context.Context, tokenId int, quantity int) (*PreparedTx, error) {
+	address := drop.Helper.GetSignerAddress().String()
+	return drop.PrepareClaimTo(ctx, address, tokenId, quantity)
+}
+
 // Claim NFTs from this contract to the connect wallet.
 //
 // tokenId: the token ID of the NFT to claim
@@ -181,24 +204,40 @@ func (drop *EditionDrop) Claim(ctx context.Context, tokenId int, quantity int) (
 //	quantity = 1
 //
 //	tx, err := contract.ClaimTo(context.Background(), address, tokenId, quantity)
-func (drop *EditionDrop) ClaimTo(ctx context.Context, destinationAddress string, tokenId int, quantity int) (*types.Transaction, error) {
-	claimVerification, err := drop.prepareClaim(ctx, tokenId, quantity)
+func (drop *EditionDrop) ClaimTo(ctx context.Context, destinationAddress string, tokenId int, quantity int) (*TransactionResult, error) {
+	tx, err := drop.PrepareClaimTo(ctx, destinationAddress, tokenId, quantity)
 	if err != nil {
 		return nil, err
 	}
 
-	active, err := drop.ClaimConditions.GetActive(ctx, tokenId)
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare a claim of NFTs from this contract to a specified wallet without sending it.
+//
+// destinationAddress: the address of the wallet to claim the NFTs to
+//
+// tokenId: the token ID of the NFT to claim
+//
+// quantity: the number of NFTs to claim
+//
+// returns: the prepared claim transaction
+//
+// Example
+//
+//	tx, err := contract.PrepareClaimTo(context.Background(), "{{wallet_address}}", 0, 1)
+//	estimate, err := tx.Estimate(context.Background())
+func (drop *EditionDrop) PrepareClaimTo(ctx context.Context, destinationAddress string, tokenId int, quantity int) (*PreparedTx, error) {
+	claimVerification, err := drop.prepareClaim(ctx, tokenId, quantity)
 	if err != nil {
 		return nil, err
 	}
 
-	txOpts, err := drop.Helper.GetTxOptions(ctx)
+	active, err := drop.ClaimConditions.GetActive(ctx, tokenId)
 	if err != nil {
 		return nil, err
 	}
 
-	txOpts.Value = claimVerification.Value
-
 	proof := abi.IDrop1155AllowlistProof{
 		Proof:                  claimVerification.Proofs,
 		QuantityLimitPerWallet: claimVerification.MaxClaimable,
@@ -206,21 +245,19 @@ func (drop *EditionDrop) ClaimTo(ctx context.Context, destinationAddress string,
 		Currency:               common.HexToAddress(claimVerification.CurrencyAddress),
 	}
 
-	tx, err := drop.abi.Claim(
-		txOpts,
-		common.HexToAddress(destinationAddress),
-		big.NewInt(int64(tokenId)),
-		big.NewInt(int64(quantity)),
-		common.HexToAddress(active.CurrencyAddress),
-		active.Price,
-		proof,
-		[]byte{},
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return drop.Helper.AwaitTx(ctx, tx.Hash())
+	return newPreparedTx(drop.Helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		opts.Value = claimVerification.Value
+		return drop.abi.Claim(
+			opts,
+			common.HexToAddress(destinationAddress),
+			big.NewInt(int64(tokenId)),
+			big.NewInt(int64(quantity)),
+			common.HexToAddress(active.CurrencyAddress),
+			active.Price,
+			proof,
+			[]byte{},
+		)
+	}), nil
 }
 
 func (drop *EditionDrop) prepareClaim(ctx context.Context, tokenId int, quantity int) (*ClaimVerification, error) {
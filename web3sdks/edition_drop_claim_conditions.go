@@ -21,7 +21,7 @@ type EditionDropClaimConditions struct {
 }
 
 func newEditionDropClaimConditions(address common.Address, provider *ethclient.Client, helper *contractHelper, storage storage) (*EditionDropClaimConditions, error) {
-	if contractAbi, err := abi.NewDropERC1155(address, provider); err != nil {
+	if contractAbi, err := abi.NewDropERC1155(address, helper.getContractBackend()); err != nil {
 		return nil, err
 	} else {
 		claimConditions := &EditionDropClaimConditions{
@@ -62,11 +62,11 @@ func (claim *EditionDropClaimConditions) GetActive(ctx context.Context, tokenId
 		return nil, err
 	}
 
-	provider := claim.helper.GetProvider()
+	backend := claim.helper.getContractBackend()
 	claimCondition, err := transformResultToClaimCondition(
 		ctx,
 		&mc,
-		provider,
+		backend,
 	)
 	if err != nil {
 		return nil, err
@@ -100,7 +100,7 @@ func (claim *EditionDropClaimConditions) GetAll(ctx context.Context, tokenId int
 		return nil, err
 	}
 
-	provider := claim.helper.GetProvider()
+	backend := claim.helper.getContractBackend()
 	startId := condition.CurrentStartId.Int64()
 	count := condition.Count.Int64()
 
@@ -114,7 +114,7 @@ func (claim *EditionDropClaimConditions) GetAll(ctx context.Context, tokenId int
 		claimCondition, err := transformResultToClaimCondition(
 			ctx,
 			&mc,
-			provider,
+			backend,
 		)
 		if err != nil {
 			return nil, err
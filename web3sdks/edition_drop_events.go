@@ -0,0 +1,301 @@
+package web3sdks
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/web3sdks/go-sdk/v2/abi"
+)
+
+// The events of the edition drop contract, decoded with the generated bindings of the contract into
+// typed events instead of the generic ContractEvent.
+//
+// All the methods of ContractEvents are available as well, like GetEvents or AddEventListener.
+//
+// Example
+//
+//	contract, err := sdk.GetEditionDrop("{{contract_address}}")
+//
+//	transfers, err := contract.Events.GetSingleTransfers(context.Background(), web3sdks.EventQueryOptions{})
+//	fmt.Println(transfers[0].Value)
+type EditionDropEvents struct {
+	*ContractEvents
+	filterer *abi.DropERC1155Filterer
+}
+
+func newEditionDropEvents(contractAbi *abi.DropERC1155, helper *contractHelper) (*EditionDropEvents, error) {
+	events, err := newContractEvents(abi.DropERC1155ABI, helper)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EditionDropEvents{
+		ContractEvents: events,
+		filterer:       &contractAbi.DropERC1155Filterer,
+	}, nil
+}
+
+// Query the past transfers of a single token, including the claims and burns.
+//
+// options: The options to use when querying for events, including block range specifications and
+// filters on the indexed arguments of the TransferSingle event
+//
+// returns: the TransferSingle events that match the query, in the order of the options
+//
+// Example
+//
+//	transfers, err := contract.Events.GetSingleTransfers(context.Background(), web3sdks.EventQueryOptions{
+//	  FromBlock: 100000000,
+//	})
+//
+//	for _, transfer := range transfers {
+//	  fmt.Println(transfer.Value, transfer.Raw.BlockNumber)
+//	}
+func (events *EditionDropEvents) GetSingleTransfers(ctx context.Context, options EventQueryOptions) ([]*abi.DropERC1155TransferSingle, error) {
+	transfers := []*abi.DropERC1155TransferSingle{}
+	err := events.queryLogs(ctx, "TransferSingle", options, func(log types.Log) error {
+		transfer, err := events.filterer.ParseTransferSingle(log)
+		if err != nil {
+			return err
+		}
+
+		transfers = append(transfers, transfer)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+// Listen in the background for the future transfers of a single token, including the claims and burns, with the same options as AddEventListener.
+//
+// listener: The listener function that will be called with every new TransferSingle event. The events
+// removed by a reorg are delivered again with Raw.Removed set to true
+//
+// options: Optional options of the listener, like the poll interval or the block to start listening from
+//
+// returns: An EventSubscription object that can be used to unsubscribe from the event or check for errors
+//
+// Example
+//
+//	subscription := contract.Events.OnTransferSingle(context.Background(), func(transfer *abi.DropERC1155TransferSingle) {
+//	  fmt.Println(transfer.Value)
+//	})
+//
+//	subscription.Unsubscribe()
+func (events *EditionDropEvents) OnTransferSingle(
+	ctx context.Context,
+	listener func(event *abi.DropERC1155TransferSingle),
+	options ...*EventListenerOptions,
+) EventSubscription {
+	return events.listenLogs(ctx, "TransferSingle", func(log types.Log) error {
+		transfer, err := events.filterer.ParseTransferSingle(log)
+		if err != nil {
+			return err
+		}
+
+		listener(transfer)
+		return nil
+	}, options)
+}
+
+// Query the past transfers of a batch of tokens, including the claims and burns.
+//
+// options: The options to use when querying for events, including block range specifications and
+// filters on the indexed arguments of the TransferBatch event
+//
+// returns: the TransferBatch events that match the query, in the order of the options
+//
+// Example
+//
+//	transfers, err := contract.Events.GetBatchTransfers(context.Background(), web3sdks.EventQueryOptions{
+//	  FromBlock: 100000000,
+//	})
+//
+//	for _, transfer := range transfers {
+//	  fmt.Println(transfer.Ids, transfer.Raw.BlockNumber)
+//	}
+func (events *EditionDropEvents) GetBatchTransfers(ctx context.Context, options EventQueryOptions) ([]*abi.DropERC1155TransferBatch, error) {
+	transfers := []*abi.DropERC1155TransferBatch{}
+	err := events.queryLogs(ctx, "TransferBatch", options, func(log types.Log) error {
+		transfer, err := events.filterer.ParseTransferBatch(log)
+		if err != nil {
+			return err
+		}
+
+		transfers = append(transfers, transfer)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+// Listen in the background for the future transfers of a batch of tokens, including the claims and burns, with the same options as AddEventListener.
+//
+// listener: The listener function that will be called with every new TransferBatch event. The events
+// removed by a reorg are delivered again with Raw.Removed set to true
+//
+// options: Optional options of the listener, like the poll interval or the block to start listening from
+//
+// returns: An EventSubscription object that can be used to unsubscribe from the event or check for errors
+//
+// Example
+//
+//	subscription := contract.Events.OnTransferBatch(context.Background(), func(transfer *abi.DropERC1155TransferBatch) {
+//	  fmt.Println(transfer.Ids)
+//	})
+//
+//	subscription.Unsubscribe()
+func (events *EditionDropEvents) OnTransferBatch(
+	ctx context.Context,
+	listener func(event *abi.DropERC1155TransferBatch),
+	options ...*EventListenerOptions,
+) EventSubscription {
+	return events.listenLogs(ctx, "TransferBatch", func(log types.Log) error {
+		transfer, err := events.filterer.ParseTransferBatch(log)
+		if err != nil {
+			return err
+		}
+
+		listener(transfer)
+		return nil
+	}, options)
+}
+
+// Query the past token claims.
+//
+// options: The options to use when querying for events, including block range specifications and
+// filters on the indexed arguments of the TokensClaimed event
+//
+// returns: the TokensClaimed events that match the query, in the order of the options
+//
+// Example
+//
+//	claims, err := contract.Events.GetTokensClaimed(context.Background(), web3sdks.EventQueryOptions{
+//	  FromBlock: 100000000,
+//	})
+//
+//	for _, claim := range claims {
+//	  fmt.Println(claim.QuantityClaimed, claim.Raw.BlockNumber)
+//	}
+func (events *EditionDropEvents) GetTokensClaimed(ctx context.Context, options EventQueryOptions) ([]*abi.DropERC1155TokensClaimed, error) {
+	claims := []*abi.DropERC1155TokensClaimed{}
+	err := events.queryLogs(ctx, "TokensClaimed", options, func(log types.Log) error {
+		claim, err := events.filterer.ParseTokensClaimed(log)
+		if err != nil {
+			return err
+		}
+
+		claims = append(claims, claim)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// Listen in the background for the future token claims, with the same options as AddEventListener.
+//
+// listener: The listener function that will be called with every new TokensClaimed event. The events
+// removed by a reorg are delivered again with Raw.Removed set to true
+//
+// options: Optional options of the listener, like the poll interval or the block to start listening from
+//
+// returns: An EventSubscription object that can be used to unsubscribe from the event or check for errors
+//
+// Example
+//
+//	subscription := contract.Events.OnTokensClaimed(context.Background(), func(claim *abi.DropERC1155TokensClaimed) {
+//	  fmt.Println(claim.QuantityClaimed)
+//	})
+//
+//	subscription.Unsubscribe()
+func (events *EditionDropEvents) OnTokensClaimed(
+	ctx context.Context,
+	listener func(event *abi.DropERC1155TokensClaimed),
+	options ...*EventListenerOptions,
+) EventSubscription {
+	return events.listenLogs(ctx, "TokensClaimed", func(log types.Log) error {
+		claim, err := events.filterer.ParseTokensClaimed(log)
+		if err != nil {
+			return err
+		}
+
+		listener(claim)
+		return nil
+	}, options)
+}
+
+// Query the past batches of tokens lazy minted.
+//
+// options: The options to use when querying for events, including block range specifications and
+// filters on the indexed arguments of the TokensLazyMinted event
+//
+// returns: the TokensLazyMinted events that match the query, in the order of the options
+//
+// Example
+//
+//	batches, err := contract.Events.GetTokensLazyMinted(context.Background(), web3sdks.EventQueryOptions{
+//	  FromBlock: 100000000,
+//	})
+//
+//	for _, batch := range batches {
+//	  fmt.Println(batch.EndTokenId, batch.Raw.BlockNumber)
+//	}
+func (events *EditionDropEvents) GetTokensLazyMinted(ctx context.Context, options EventQueryOptions) ([]*abi.DropERC1155TokensLazyMinted, error) {
+	batches := []*abi.DropERC1155TokensLazyMinted{}
+	err := events.queryLogs(ctx, "TokensLazyMinted", options, func(log types.Log) error {
+		batch, err := events.filterer.ParseTokensLazyMinted(log)
+		if err != nil {
+			return err
+		}
+
+		batches = append(batches, batch)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return batches, nil
+}
+
+// Listen in the background for the future batches of tokens lazy minted, with the same options as AddEventListener.
+//
+// listener: The listener function that will be called with every new TokensLazyMinted event. The events
+// removed by a reorg are delivered again with Raw.Removed set to true
+//
+// options: Optional options of the listener, like the poll interval or the block to start listening from
+//
+// returns: An EventSubscription object that can be used to unsubscribe from the event or check for errors
+//
+// Example
+//
+//	subscription := contract.Events.OnTokensLazyMinted(context.Background(), func(batch *abi.DropERC1155TokensLazyMinted) {
+//	  fmt.Println(batch.EndTokenId)
+//	})
+//
+//	subscription.Unsubscribe()
+func (events *EditionDropEvents) OnTokensLazyMinted(
+	ctx context.Context,
+	listener func(event *abi.DropERC1155TokensLazyMinted),
+	options ...*EventListenerOptions,
+) EventSubscription {
+	return events.listenLogs(ctx, "TokensLazyMinted", func(log types.Log) error {
+		batch, err := events.filterer.ParseTokensLazyMinted(log)
+		if err != nil {
+			return err
+		}
+
+		listener(batch)
+		return nil
+	}, options)
+}
@@ -0,0 +1,235 @@
+package web3sdks
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/web3sdks/go-sdk/v2/abi"
+)
+
+// The events of the edition contract, decoded with the generated bindings of the contract into
+// typed events instead of the generic ContractEvent.
+//
+// All the methods of ContractEvents are available as well, like GetEvents or AddEventListener.
+//
+// Example
+//
+//	contract, err := sdk.GetEdition("{{contract_address}}")
+//
+//	transfers, err := contract.Events.GetSingleTransfers(context.Background(), web3sdks.EventQueryOptions{})
+//	fmt.Println(transfers[0].Value)
+type EditionEvents struct {
+	*ContractEvents
+	filterer *abi.TokenERC1155Filterer
+}
+
+func newEditionEvents(contractAbi *abi.TokenERC1155, helper *contractHelper) (*EditionEvents, error) {
+	events, err := newContractEvents(abi.TokenERC1155ABI, helper)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EditionEvents{
+		ContractEvents: events,
+		filterer:       &contractAbi.TokenERC1155Filterer,
+	}, nil
+}
+
+// Query the past transfers of a single token, including the mints and burns.
+//
+// options: The options to use when querying for events, including block range specifications and
+// filters on the indexed arguments of the TransferSingle event
+//
+// returns: the TransferSingle events that match the query, in the order of the options
+//
+// Example
+//
+//	transfers, err := contract.Events.GetSingleTransfers(context.Background(), web3sdks.EventQueryOptions{
+//	  FromBlock: 100000000,
+//	})
+//
+//	for _, transfer := range transfers {
+//	  fmt.Println(transfer.Value, transfer.Raw.BlockNumber)
+//	}
+func (events *EditionEvents) GetSingleTransfers(ctx context.Context, options EventQueryOptions) ([]*abi.TokenERC1155TransferSingle, error) {
+	transfers := []*abi.TokenERC1155TransferSingle{}
+	err := events.queryLogs(ctx, "TransferSingle", options, func(log types.Log) error {
+		transfer, err := events.filterer.ParseTransferSingle(log)
+		if err != nil {
+			return err
+		}
+
+		transfers = append(transfers, transfer)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+// Listen in the background for the future transfers of a single token, including the mints and burns, with the same options as AddEventListener.
+//
+// listener: The listener function that will be called with every new TransferSingle event. The events
+// removed by a reorg are delivered again with Raw.Removed set to true
+//
+// options: Optional options of the listener, like the poll interval or the block to start listening from
+//
+// returns: An EventSubscription object that can be used to unsubscribe from the event or check for errors
+//
+// Example
+//
+//	subscription := contract.Events.OnTransferSingle(context.Background(), func(transfer *abi.TokenERC1155TransferSingle) {
+//	  fmt.Println(transfer.Value)
+//	})
+//
+//	subscription.Unsubscribe()
+func (events *EditionEvents) OnTransferSingle(
+	ctx context.Context,
+	listener func(event *abi.TokenERC1155TransferSingle),
+	options ...*EventListenerOptions,
+) EventSubscription {
+	return events.listenLogs(ctx, "TransferSingle", func(log types.Log) error {
+		transfer, err := events.filterer.ParseTransferSingle(log)
+		if err != nil {
+			return err
+		}
+
+		listener(transfer)
+		return nil
+	}, options)
+}
+
+// Query the past transfers of a batch of tokens, including the mints and burns.
+//
+// options: The options to use when querying for events, including block range specifications and
+// filters on the indexed arguments of the TransferBatch event
+//
+// returns: the TransferBatch events that match the query, in the order of the options
+//
+// Example
+//
+//	transfers, err := contract.Events.GetBatchTransfers(context.Background(), web3sdks.EventQueryOptions{
+//	  FromBlock: 100000000,
+//	})
+//
+//	for _, transfer := range transfers {
+//	  fmt.Println(transfer.Ids, transfer.Raw.BlockNumber)
+//	}
+func (events *EditionEvents) GetBatchTransfers(ctx context.Context, options EventQueryOptions) ([]*abi.TokenERC1155TransferBatch, error) {
+	transfers := []*abi.TokenERC1155TransferBatch{}
+	err := events.queryLogs(ctx, "TransferBatch", options, func(log types.Log) error {
+		transfer, err := events.filterer.ParseTransferBatch(log)
+		if err != nil {
+			return err
+		}
+
+		transfers = append(transfers, transfer)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+// Listen in the background for the future transfers of a batch of tokens, including the mints and burns, with the same options as AddEventListener.
+//
+// listener: The listener function that will be called with every new TransferBatch event. The events
+// removed by a reorg are delivered again with Raw.Removed set to true
+//
+// options: Optional options of the listener, like the poll interval or the block to start listening from
+//
+// returns: An EventSubscription object that can be used to unsubscribe from the event or check for errors
+//
+// Example
+//
+//	subscription := contract.Events.OnTransferBatch(context.Background(), func(transfer *abi.TokenERC1155TransferBatch) {
+//	  fmt.Println(transfer.Ids)
+//	})
+//
+//	subscription.Unsubscribe()
+func (events *EditionEvents) OnTransferBatch(
+	ctx context.Context,
+	listener func(event *abi.TokenERC1155TransferBatch),
+	options ...*EventListenerOptions,
+) EventSubscription {
+	return events.listenLogs(ctx, "TransferBatch", func(log types.Log) error {
+		transfer, err := events.filterer.ParseTransferBatch(log)
+		if err != nil {
+			return err
+		}
+
+		listener(transfer)
+		return nil
+	}, options)
+}
+
+// Query the past tokens minted.
+//
+// options: The options to use when querying for events, including block range specifications and
+// filters on the indexed arguments of the TokensMinted event
+//
+// returns: the TokensMinted events that match the query, in the order of the options
+//
+// Example
+//
+//	mints, err := contract.Events.GetTokensMinted(context.Background(), web3sdks.EventQueryOptions{
+//	  FromBlock: 100000000,
+//	})
+//
+//	for _, mint := range mints {
+//	  fmt.Println(mint.QuantityMinted, mint.Raw.BlockNumber)
+//	}
+func (events *EditionEvents) GetTokensMinted(ctx context.Context, options EventQueryOptions) ([]*abi.TokenERC1155TokensMinted, error) {
+	mints := []*abi.TokenERC1155TokensMinted{}
+	err := events.queryLogs(ctx, "TokensMinted", options, func(log types.Log) error {
+		mint, err := events.filterer.ParseTokensMinted(log)
+		if err != nil {
+			return err
+		}
+
+		mints = append(mints, mint)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mints, nil
+}
+
+// Listen in the background for the future tokens minted, with the same options as AddEventListener.
+//
+// listener: The listener function that will be called with every new TokensMinted event. The events
+// removed by a reorg are delivered again with Raw.Removed set to true
+//
+// options: Optional options of the listener, like the poll interval or the block to start listening from
+//
+// returns: An EventSubscription object that can be used to unsubscribe from the event or check for errors
+//
+// Example
+//
+//	subscription := contract.Events.OnTokensMinted(context.Background(), func(mint *abi.TokenERC1155TokensMinted) {
+//	  fmt.Println(mint.QuantityMinted)
+//	})
+//
+//	subscription.Unsubscribe()
+func (events *EditionEvents) OnTokensMinted(
+	ctx context.Context,
+	listener func(event *abi.TokenERC1155TokensMinted),
+	options ...*EventListenerOptions,
+) EventSubscription {
+	return events.listenLogs(ctx, "TokensMinted", func(log types.Log) error {
+		mint, err := events.filterer.ParseTokensMinted(log)
+		if err != nil {
+			return err
+		}
+
+		listener(mint)
+		return nil
+	}, options)
+}
@@ -2,9 +2,7 @@ package web3sdks
 
 import (
 	"context"
-	"fmt"
 	"math/big"
-	"sort"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
@@ -20,6 +18,7 @@ type ERC1155 struct {
 	abi     *abi.TokenERC1155
 	helper  *contractHelper
 	storage storage
+	events  *ContractEvents
 }
 
 type EditionResult struct {
@@ -27,16 +26,19 @@ type EditionResult struct {
 	err error
 }
 
-func newERC1155(provider *ethclient.Client, address common.Address, privateKey string, storage storage) (*ERC1155, error) {
-	if contractAbi, err := abi.NewTokenERC1155(address, provider); err != nil {
+func newERC1155(provider *ethclient.Client, address common.Address, handler *ProviderHandler, storage storage) (*ERC1155, error) {
+	if contractAbi, err := abi.NewTokenERC1155(address, handler.getContractBackend()); err != nil {
 		return nil, err
-	} else if helper, err := newContractHelper(address, provider, privateKey); err != nil {
+	} else if helper, err := newContractHelper(address, handler); err != nil {
+		return nil, err
+	} else if events, err := newContractEvents(abi.TokenERC1155ABI, helper); err != nil {
 		return nil, err
 	} else {
 		return &ERC1155{
 			contractAbi,
 			helper,
 			storage,
+			events,
 		}, nil
 	}
 }
@@ -71,25 +73,112 @@ func (erc1155 *ERC1155) Get(ctx context.Context, tokenId int) (*EditionMetadata,
 
 // Get the metadata of all the NFTs on this contract.
 //
-// returns: the metadatas and supplies of all the NFTs on this contract
+// params: optional page of the NFTs to get, all the NFTs are returned by default
+//
+// returns: the metadatas and supplies of all the NFTs on this contract. If some of the NFTs
+// can't be fetched, the others are returned along with a *PartialResultError
 //
 // Example
 //
 //	nfts, err := contract.GetAll(context.Background())
 //	supplyOne := nfts[0].Supply
 //	nameOne := nfts[0].Metadata.Name
-func (erc1155 *ERC1155) GetAll(ctx context.Context) ([]*EditionMetadata, error) {
+//
+//	// get the NFTs 100 to 149
+//	page, err := contract.GetAll(context.Background(), &web3sdks.QueryAllParams{Start: 100, Count: 50})
+func (erc1155 *ERC1155) GetAll(ctx context.Context, params ...*QueryAllParams) ([]*EditionMetadata, error) {
 	if totalCount, err := erc1155.GetTotalCount(ctx); err != nil {
 		return nil, err
 	} else {
-		tokenIds := []*big.Int{}
-		for i := 0; i < totalCount; i++ {
-			tokenIds = append(tokenIds, big.NewInt(int64(i)))
+		tokenIds, err := getPageTokenIds(0, totalCount, params)
+		if err != nil {
+			return nil, err
 		}
 		return fetchEditionsByTokenId(ctx, erc1155, tokenIds)
 	}
 }
 
+// Iterate over all the NFTs on this contract, fetching them page by page without holding the
+// whole collection in memory.
+//
+// pageSize: the number of NFTs fetched by every call to Next, defaults to 100
+//
+// returns: the iterator over the NFTs that exist when it is created
+//
+// Example
+//
+//	iterator, err := contract.Iterate(context.Background(), 500)
+//	for iterator.HasNext() {
+//		nfts, err := iterator.Next(context.Background())
+//		...
+//	}
+func (erc1155 *ERC1155) Iterate(ctx context.Context, pageSize int) (*EditionIterator, error) {
+	totalCount, err := erc1155.GetTotalCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EditionIterator{erc1155: erc1155, pages: newTokenIdPages(0, totalCount, pageSize)}, nil
+}
+
+// Get the history of an NFT, from the TransferSingle and TransferBatch events.
+//
+// Since the token IDs of the transfer events aren't indexed, all the transfers of the contract in
+// the block range of the options are fetched and filtered by token ID.
+//
+// tokenId: the token ID of the NFT to get the history of
+//
+// options: optional options of the query of the transfer events, like the block range, the order
+// or the limit. The filters are ignored
+//
+// returns: the mints, transfers and burns of the NFT with their block timestamps, from the oldest
+// to the most recent by default
+//
+// Example
+//
+//	history, err := contract.GetHistory(context.Background(), 0)
+//	for _, activity := range history {
+//		fmt.Println(activity.Type, activity.Quantity, activity.From, activity.To, activity.Timestamp)
+//	}
+func (erc1155 *ERC1155) GetHistory(ctx context.Context, tokenId int, options ...*EventQueryOptions) ([]*TokenActivity, error) {
+	queries := []tokenActivityQuery{{eventName: "TransferSingle"}, {eventName: "TransferBatch"}}
+
+	// The events are only limited once the transfers of other tokens are left out
+	queryOptions := getTokenActivityOptions(options)
+	limit := queryOptions.Limit
+	queryOptions.Limit = 0
+
+	return erc1155.getActivity(ctx, queries, queryOptions, limit, big.NewInt(int64(tokenId)))
+}
+
+// Get the activity of a wallet on this contract, from the TransferSingle and TransferBatch events.
+//
+// address: the address of the wallet to get the activity of
+//
+// options: optional options of the query of the transfer events, like the block range, the order
+// or the limit. The filters are ignored
+//
+// returns: the NFTs minted to, transferred from or to, and burned by the wallet with their block
+// timestamps, from the oldest to the most recent by default. Every NFT of a batch transfer is
+// returned as a separate activity
+//
+// Example
+//
+//	activity, err := contract.GetActivity(context.Background(), "{{wallet_address}}")
+func (erc1155 *ERC1155) GetActivity(ctx context.Context, address string, options ...*EventQueryOptions) ([]*TokenActivity, error) {
+	wallet := common.HexToAddress(address)
+	queries := []tokenActivityQuery{}
+	for _, eventName := range []string{"TransferSingle", "TransferBatch"} {
+		queries = append(queries,
+			tokenActivityQuery{eventName: eventName, filters: map[string]interface{}{"from": wallet}},
+			tokenActivityQuery{eventName: eventName, filters: map[string]interface{}{"to": wallet}},
+		)
+	}
+
+	queryOptions := getTokenActivityOptions(options)
+	return erc1155.getActivity(ctx, queries, queryOptions, queryOptions.Limit, nil)
+}
+
 // Get the total number of NFTs on this contract.
 //
 // returns: the total number of NFTs on this contract
@@ -106,7 +195,8 @@ func (erc1155 *ERC1155) GetTotalCount(ctx context.Context) (int, error) {
 //
 // address: the address of the owner of the NFTs
 //
-// returns: the metadatas and supplies of all the NFTs owned by the address
+// returns: the metadatas and supplies of all the NFTs owned by the address. If some of the NFTs
+// can't be fetched, the others are returned along with a *PartialResultError
 //
 // Example
 //
@@ -136,13 +226,13 @@ func (erc1155 *ERC1155) GetOwned(ctx context.Context, address string) ([]*Editio
 	}
 
 	metadataOwners := []*EditionMetadataOwner{}
-	metadatas, err := fetchEditionsByTokenId(ctx, erc1155, ids)
+	metadatas, errs, err := erc1155.fetchEditions(ctx, ids)
 	if err != nil {
 		return nil, err
 	}
 	for index, balance := range balances {
 		metadata := metadatas[index]
-		if err == nil {
+		if errs[index] == nil {
 			metadataOwner := &EditionMetadataOwner{
 				Metadata:      metadata.Metadata,
 				Supply:        metadata.Supply,
@@ -153,7 +243,7 @@ func (erc1155 *ERC1155) GetOwned(ctx context.Context, address string) ([]*Editio
 		}
 	}
 
-	return metadataOwners, nil
+	return metadataOwners, newPartialResultError(ids, errs)
 }
 
 // Get the total number of NFTs of a specific token ID.
@@ -228,23 +318,41 @@ func (erc1155 *ERC1155) IsApproved(ctx context.Context, address string, operator
 //	amount := 1
 //
 //	tx, err := contract.Transfer(context.Background(), to, tokenId, amount)
-func (erc1155 *ERC1155) Transfer(ctx context.Context, to string, tokenId int, amount int) (*types.Transaction, error) {
-	txOpts, err := erc1155.helper.GetTxOptions(ctx)
+func (erc1155 *ERC1155) Transfer(ctx context.Context, to string, tokenId int, amount int) (*TransactionResult, error) {
+	tx, err := erc1155.PrepareTransfer(ctx, to, tokenId, amount)
 	if err != nil {
 		return nil, err
 	}
-	if tx, err := erc1155.abi.SafeTransferFrom(
-		txOpts,
-		erc1155.helper.GetSignerAddress(),
-		common.HexToAddress(to),
-		big.NewInt(int64(tokenId)),
-		big.NewInt(int64(amount)),
-		[]byte{},
-	); err != nil {
-		return nil, err
-	} else {
-		return erc1155.helper.AwaitTx(ctx, tx.Hash())
-	}
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the transfer of an amount of a specified token from the connected wallet to a
+// specified address without sending it.
+//
+// to: wallet address to transfer the tokens to
+//
+// tokenId: the token ID of the NFT to transfer
+//
+// amount: number of NFTs of the token ID to transfer
+//
+// returns: the prepared transfer transaction
+//
+// Example
+//
+//	tx, err := contract.PrepareTransfer(context.Background(), "0x...", 0, 1)
+//	estimate, err := tx.Estimate(context.Background())
+func (erc1155 *ERC1155) PrepareTransfer(ctx context.Context, to string, tokenId int, amount int) (*PreparedTx, error) {
+	return newPreparedTx(erc1155.helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return erc1155.abi.SafeTransferFrom(
+			opts,
+			opts.From,
+			common.HexToAddress(to),
+			big.NewInt(int64(tokenId)),
+			big.NewInt(int64(amount)),
+			[]byte{},
+		)
+	}), nil
 }
 
 // Burn an amount of a specified NFT from the connected wallet.
@@ -260,22 +368,31 @@ func (erc1155 *ERC1155) Transfer(ctx context.Context, to string, tokenId int, am
 //	tokenId := 0
 //	amount := 1
 //	tx, err := contract.Burn(context.Background(), tokenId, amount)
-func (erc1155 *ERC1155) Burn(ctx context.Context, tokenId int, amount int) (*types.Transaction, error) {
-	address := erc1155.helper.GetSignerAddress()
-	txOpts, err := erc1155.helper.GetTxOptions(ctx)
+func (erc1155 *ERC1155) Burn(ctx context.Context, tokenId int, amount int) (*TransactionResult, error) {
+	tx, err := erc1155.PrepareBurn(ctx, tokenId, amount)
 	if err != nil {
 		return nil, err
 	}
-	if tx, err := erc1155.abi.Burn(
-		txOpts,
-		address,
-		big.NewInt(int64(tokenId)),
-		big.NewInt(int64(amount)),
-	); err != nil {
-		return nil, err
-	} else {
-		return erc1155.helper.AwaitTx(ctx, tx.Hash())
-	}
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the burn of an amount of a specified NFT from the connected wallet without sending it.
+//
+// tokenId: tokenID of the token to burn
+//
+// amount: number of NFTs of the token ID to burn
+//
+// returns: the prepared burn transaction
+func (erc1155 *ERC1155) PrepareBurn(ctx context.Context, tokenId int, amount int) (*PreparedTx, error) {
+	return newPreparedTx(erc1155.helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return erc1155.abi.Burn(
+			opts,
+			opts.From,
+			big.NewInt(int64(tokenId)),
+			big.NewInt(int64(amount)),
+		)
+	}), nil
 }
 
 // Set the approval for all operations of a specific address's assets.
@@ -287,20 +404,27 @@ func (erc1155 *ERC1155) Burn(ctx context.Context, tokenId int, amount int) (*typ
 // approved: true if the operator is approved for all operations of the assets, otherwise false
 //
 // returns: the transaction receipt of the approval
-func (erc1155 *ERC1155) SetApprovalForAll(ctx context.Context, operator string, approved bool) (*types.Transaction, error) {
-	txOpts, err := erc1155.helper.GetTxOptions(ctx)
+func (erc1155 *ERC1155) SetApprovalForAll(ctx context.Context, operator string, approved bool) (*TransactionResult, error) {
+	tx, err := erc1155.PrepareSetApprovalForAll(ctx, operator, approved)
 	if err != nil {
 		return nil, err
 	}
-	if tx, err := erc1155.abi.SetApprovalForAll(
-		txOpts,
-		common.HexToAddress(operator),
-		approved,
-	); err != nil {
-		return nil, err
-	} else {
-		return erc1155.helper.AwaitTx(ctx, tx.Hash())
-	}
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare setting the approval of an operator for all the assets of the connected wallet
+// without sending it.
+//
+// operator: the address of the operator to set the approval for
+//
+// approved: true if the operator is approved for all operations of the assets, otherwise false
+//
+// returns: the prepared approval transaction
+func (erc1155 *ERC1155) PrepareSetApprovalForAll(ctx context.Context, operator string, approved bool) (*PreparedTx, error) {
+	return newPreparedTx(erc1155.helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return erc1155.abi.SetApprovalForAll(opts, common.HexToAddress(operator), approved)
+	}), nil
 }
 
 func (erc1155 *ERC1155) getTokenMetadata(ctx context.Context, tokenId int) (*NFTMetadata, error) {
@@ -308,8 +432,9 @@ func (erc1155 *ERC1155) getTokenMetadata(ctx context.Context, tokenId int) (*NFT
 		&bind.CallOpts{Context: ctx},
 		big.NewInt(int64(tokenId)),
 	); err != nil {
-		return nil, &notFoundError{
-			tokenId,
+		return nil, &NotFoundError{
+			TypeName:   "token",
+			Identifier: tokenId,
 		}
 	} else {
 		if nft, err := fetchTokenMetadata(ctx, tokenId, uri, erc1155.storage); err != nil {
@@ -321,35 +446,123 @@ func (erc1155 *ERC1155) getTokenMetadata(ctx context.Context, tokenId int) (*NFT
 }
 
 func fetchEditionsByTokenId(ctx context.Context, erc1155 *ERC1155, tokenIds []*big.Int) ([]*EditionMetadata, error) {
+	nfts, errs, err := erc1155.fetchEditions(ctx, tokenIds)
+	if err != nil {
+		return nil, err
+	}
+
+	// keep the order of the token ids, leaving out the failed nfts
+	fetched := []*EditionMetadata{}
+	for i, nft := range nfts {
+		if errs[i] == nil {
+			fetched = append(fetched, nft)
+		}
+	}
+
+	return fetched, newPartialResultError(tokenIds, errs)
+}
+
+// Fetch nfts from a bounded pool of workers, returning the nft or the error of every token id
+func (erc1155 *ERC1155) fetchEditions(ctx context.Context, tokenIds []*big.Int) ([]*EditionMetadata, []error, error) {
 	total := len(tokenIds)
 
-	ch := make(chan *EditionResult)
-	// fetch all nfts in parallel
-	for i := 0; i < total; i++ {
-		go func(id int) {
-			if nft, err := erc1155.Get(ctx, id); err == nil {
-				ch <- &EditionResult{nft, nil}
-			} else {
-				fmt.Println(err)
-				ch <- &EditionResult{nil, err}
+	contractAbi, err := abi.TokenERC1155MetaData.GetAbi()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// read the uris and supplies of all nfts in as few requests as possible
+	reads := []*contractRead{}
+	for _, tokenId := range tokenIds {
+		for _, method := range []string{"uri", "totalSupply"} {
+			read, err := newContractRead(erc1155.helper.getAddress(), contractAbi, method, tokenId)
+			if err != nil {
+				return nil, nil, err
 			}
-		}(i)
+			reads = append(reads, read)
+		}
+	}
+	readResults := erc1155.helper.batchRead(ctx, reads)
+
+	nfts := make([]*EditionMetadata, total)
+	errs := make([]error, total)
+	forEachInParallel(ctx, total, erc1155.helper.getFetchConcurrency(), func(i int) {
+		nfts[i], errs[i] = erc1155.fetchEdition(ctx, tokenIds[i], readResults[2*i], readResults[2*i+1])
+	})
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
 	}
-	// wait for all goroutines to emit
-	results := make([]*EditionResult, total)
-	for i := range results {
-		results[i] = <-ch
+
+	return nfts, errs, nil
+}
+
+// Fetch the metadata of an NFT from the batched reads of its uri and supply
+func (erc1155 *ERC1155) fetchEdition(ctx context.Context, tokenId *big.Int, uriResult *readResult, supplyResult *readResult) (*EditionMetadata, error) {
+	supply := 0
+	totalSupply := big.NewInt(0)
+	if err := supplyResult.unpackInto(&totalSupply); err == nil {
+		supply = int(totalSupply.Int64())
 	}
-	// filter out errors
-	nfts := []*EditionMetadata{}
-	for _, res := range results {
-		if res.nft != nil {
-			nfts = append(nfts, res.nft)
+
+	uri := ""
+	if err := uriResult.unpackInto(&uri); err != nil {
+		return nil, &NotFoundError{
+			TypeName:   "token",
+			Identifier: int(tokenId.Int64()),
 		}
 	}
-	// Sort by ID
-	sort.SliceStable(nfts, func(i, j int) bool {
-		return nfts[i].Metadata.Id.Cmp(nfts[j].Metadata.Id) < 0
-	})
-	return nfts, nil
+
+	metadata, err := fetchTokenMetadata(ctx, int(tokenId.Int64()), uri, erc1155.storage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EditionMetadata{
+		Metadata: metadata,
+		Supply:   supply,
+	}, nil
+}
+
+// Get the activities of the transfer events of the queries, only keeping the activities of a token
+// if there is one, up to the limit
+func (erc1155 *ERC1155) getActivity(
+	ctx context.Context,
+	queries []tokenActivityQuery,
+	options EventQueryOptions,
+	limit int,
+	tokenId *big.Int,
+) ([]*TokenActivity, error) {
+	events, err := getTokenActivityEvents(ctx, erc1155.events, queries, options)
+	if err != nil {
+		return nil, err
+	}
+
+	activities := []*TokenActivity{}
+	for _, event := range events {
+		ids := []*big.Int{}
+		values := []*big.Int{}
+		if event.EventName == "TransferSingle" {
+			ids = append(ids, event.Data["id"].(*big.Int))
+			values = append(values, event.Data["value"].(*big.Int))
+		} else {
+			ids = event.Data["ids"].([]*big.Int)
+			values = event.Data["values"].([]*big.Int)
+		}
+
+		for i, id := range ids {
+			if tokenId == nil || id.Cmp(tokenId) == 0 {
+				activities = append(activities, newTokenActivity(event, id, values[i]))
+			}
+		}
+	}
+
+	if limit > 0 && len(activities) > limit {
+		activities = activities[:limit]
+	}
+
+	if err := setTokenActivityTimestamps(ctx, erc1155.helper, activities); err != nil {
+		return nil, err
+	}
+
+	return activities, nil
 }
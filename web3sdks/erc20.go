@@ -14,16 +14,20 @@ import (
 
 // This interface is currently support by the Token contract. You can access
 // all of its functions through a Token contract instance.
+//
+// The amounts are floats by default, which can't represent every amount of a token with many
+// decimals. The methods taking an amount also have a String variant taking an exact decimal amount
+// like "1.5", and a BigInt variant taking an amount in the smallest unit of the token.
 type ERC20 struct {
 	abi     *abi.TokenERC20
 	helper  *contractHelper
 	storage storage
 }
 
-func newERC20(provider *ethclient.Client, address common.Address, privateKey string, storage storage) (*ERC20, error) {
-	if contractAbi, err := abi.NewTokenERC20(address, provider); err != nil {
+func newERC20(provider *ethclient.Client, address common.Address, handler *ProviderHandler, storage storage) (*ERC20, error) {
+	if contractAbi, err := abi.NewTokenERC20(address, handler.getContractBackend()); err != nil {
 		return nil, err
-	} else if helper, err := newContractHelper(address, provider, privateKey); err != nil {
+	} else if helper, err := newContractHelper(address, handler); err != nil {
 		return nil, err
 	} else {
 		return &ERC20{
@@ -43,7 +47,7 @@ func newERC20(provider *ethclient.Client, address common.Address, privateKey str
 //	currency, err := contract.Get()
 //	symbol := currency.Symbol
 func (erc20 *ERC20) Get(ctx context.Context) (*Currency, error) {
-	return fetchCurrencyMetadata(ctx, erc20.helper.GetProvider(), erc20.helper.getAddress().String())
+	return fetchCurrencyMetadata(ctx, erc20.helper.getContractBackend(), erc20.helper.getAddress().String())
 }
 
 // Get the token balance of the connected wallet.
@@ -144,22 +148,109 @@ func (erc20 *ERC20) AllowanceOf(ctx context.Context, owner string, spender strin
 //	amount := 1
 //
 //	tx, err := contract.Transfer(context.Background(), to, amount)
-func (erc20 *ERC20) Transfer(ctx context.Context, to string, amount float64) (*types.Transaction, error) {
+func (erc20 *ERC20) Transfer(ctx context.Context, to string, amount float64) (*TransactionResult, error) {
+	tx, err := erc20.PrepareTransfer(ctx, to, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the transfer of a specified amount of tokens from the connected wallet to a specified
+// address without sending it.
+//
+// to: address to transfer the tokens to
+//
+// amount: amount of tokens to transfer
+//
+// returns: the prepared transfer transaction
+//
+// Example
+//
+//	tx, err := contract.PrepareTransfer(context.Background(), "0x...", 1)
+//	estimate, err := tx.Estimate(context.Background())
+func (erc20 *ERC20) PrepareTransfer(ctx context.Context, to string, amount float64) (*PreparedTx, error) {
 	amountWithDecimals, err := erc20.normalizeAmount(ctx, amount)
 	if err != nil {
 		return nil, err
 	}
 
-	txOpts, err := erc20.helper.GetTxOptions(ctx)
+	return erc20.PrepareTransferBigInt(ctx, to, amountWithDecimals)
+}
+
+// Transfer tokens from the connected wallet to a specified address, with an exact decimal amount
+// like "1.5".
+//
+// to: address to transfer the tokens to
+//
+// amount: exact decimal amount of tokens, with at most as many decimals as the token
+//
+// returns: the transaction receipt
+//
+// Example
+//
+//	tx, err := contract.TransferString(context.Background(), "0x...", "0.000000000000000001")
+func (erc20 *ERC20) TransferString(ctx context.Context, to string, amount string) (*TransactionResult, error) {
+	tx, err := erc20.PrepareTransferString(ctx, to, amount)
 	if err != nil {
 		return nil, err
 	}
-	tx, err := erc20.abi.Transfer(txOpts, common.HexToAddress(to), amountWithDecimals)
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the transfer of tokens from the connected wallet to a specified address with an exact
+// decimal amount without sending it.
+//
+// to: address to transfer the tokens to
+//
+// amount: exact decimal amount of tokens, with at most as many decimals as the token
+//
+// returns: the prepared transaction
+func (erc20 *ERC20) PrepareTransferString(ctx context.Context, to string, amount string) (*PreparedTx, error) {
+	amountWithDecimals, err := erc20.normalizeAmountString(ctx, amount)
 	if err != nil {
 		return nil, err
 	}
 
-	return erc20.helper.AwaitTx(ctx, tx.Hash())
+	return erc20.PrepareTransferBigInt(ctx, to, amountWithDecimals)
+}
+
+// Transfer tokens from the connected wallet to a specified address, with an amount in the smallest
+// unit of the token, like wei for a token with 18 decimals.
+//
+// to: address to transfer the tokens to
+//
+// amount: amount of tokens in the smallest unit of the token
+//
+// returns: the transaction receipt
+//
+// Example
+//
+//	amount, _ := new(big.Int).SetString("1500000000000000000", 10)
+//	tx, err := contract.TransferBigInt(context.Background(), "0x...", amount)
+func (erc20 *ERC20) TransferBigInt(ctx context.Context, to string, amount *big.Int) (*TransactionResult, error) {
+	tx, err := erc20.PrepareTransferBigInt(ctx, to, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the transfer of tokens from the connected wallet to a specified address with an amount in
+// the smallest unit of the token without sending it.
+//
+// to: address to transfer the tokens to
+//
+// amount: amount of tokens in the smallest unit of the token
+//
+// returns: the prepared transaction
+func (erc20 *ERC20) PrepareTransferBigInt(ctx context.Context, to string, amount *big.Int) (*PreparedTx, error) {
+	return newPreparedTx(erc20.helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return erc20.abi.Transfer(opts, common.HexToAddress(to), amount)
+	}), nil
 }
 
 // Transfer a specified amount of tokens from one specified address to another.
@@ -179,22 +270,113 @@ func (erc20 *ERC20) Transfer(ctx context.Context, to string, amount float64) (*t
 //	amount := 1
 //
 //	tx, err := contract.TransferFrom(context.Background(), from, to, amount)
-func (erc20 *ERC20) TransferFrom(ctx context.Context, from string, to string, amount float64) (*types.Transaction, error) {
+func (erc20 *ERC20) TransferFrom(ctx context.Context, from string, to string, amount float64) (*TransactionResult, error) {
+	tx, err := erc20.PrepareTransferFrom(ctx, from, to, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the transfer of a specified amount of tokens from one specified address to another
+// without sending it.
+//
+// from: address to transfer the tokens from
+//
+// to: address to transfer the tokens to
+//
+// amount: amount of tokens to transfer
+//
+// returns: the prepared transfer transaction
+func (erc20 *ERC20) PrepareTransferFrom(ctx context.Context, from string, to string, amount float64) (*PreparedTx, error) {
 	amountWithDecimals, err := erc20.normalizeAmount(ctx, amount)
 	if err != nil {
 		return nil, err
 	}
 
-	txOpts, err := erc20.helper.GetTxOptions(ctx)
+	return erc20.PrepareTransferFromBigInt(ctx, from, to, amountWithDecimals)
+}
+
+// Transfer tokens from one specified address to another, with an exact decimal amount like "1.5".
+//
+// from: address to transfer the tokens from
+//
+// to: address to transfer the tokens to
+//
+// amount: exact decimal amount of tokens, with at most as many decimals as the token
+//
+// returns: the transaction receipt
+//
+// Example
+//
+//	tx, err := contract.TransferFromString(context.Background(), "{{wallet_address}}", "0x...", "0.000000000000000001")
+func (erc20 *ERC20) TransferFromString(ctx context.Context, from string, to string, amount string) (*TransactionResult, error) {
+	tx, err := erc20.PrepareTransferFromString(ctx, from, to, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the transfer of tokens from one specified address to another with an exact decimal amount
+// without sending it.
+//
+// from: address to transfer the tokens from
+//
+// to: address to transfer the tokens to
+//
+// amount: exact decimal amount of tokens, with at most as many decimals as the token
+//
+// returns: the prepared transaction
+func (erc20 *ERC20) PrepareTransferFromString(ctx context.Context, from string, to string, amount string) (*PreparedTx, error) {
+	amountWithDecimals, err := erc20.normalizeAmountString(ctx, amount)
 	if err != nil {
 		return nil, err
 	}
-	tx, err := erc20.abi.TransferFrom(txOpts, common.HexToAddress(from), common.HexToAddress(to), amountWithDecimals)
+
+	return erc20.PrepareTransferFromBigInt(ctx, from, to, amountWithDecimals)
+}
+
+// Transfer tokens from one specified address to another, with an amount in the smallest unit of the
+// token, like wei for a token with 18 decimals.
+//
+// from: address to transfer the tokens from
+//
+// to: address to transfer the tokens to
+//
+// amount: amount of tokens in the smallest unit of the token
+//
+// returns: the transaction receipt
+//
+// Example
+//
+//	amount, _ := new(big.Int).SetString("1500000000000000000", 10)
+//	tx, err := contract.TransferFromBigInt(context.Background(), "{{wallet_address}}", "0x...", amount)
+func (erc20 *ERC20) TransferFromBigInt(ctx context.Context, from string, to string, amount *big.Int) (*TransactionResult, error) {
+	tx, err := erc20.PrepareTransferFromBigInt(ctx, from, to, amount)
 	if err != nil {
 		return nil, err
 	}
 
-	return erc20.helper.AwaitTx(ctx, tx.Hash())
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the transfer of tokens from one specified address to another with an amount in the
+// smallest unit of the token without sending it.
+//
+// from: address to transfer the tokens from
+//
+// to: address to transfer the tokens to
+//
+// amount: amount of tokens in the smallest unit of the token
+//
+// returns: the prepared transaction
+func (erc20 *ERC20) PrepareTransferFromBigInt(ctx context.Context, from string, to string, amount *big.Int) (*PreparedTx, error) {
+	return newPreparedTx(erc20.helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return erc20.abi.TransferFrom(opts, common.HexToAddress(from), common.HexToAddress(to), amount)
+	}), nil
 }
 
 // Sets the allowance of a wallet to spend the connected wallets funds.
@@ -211,22 +393,103 @@ func (erc20 *ERC20) TransferFrom(ctx context.Context, from string, to string, am
 //	amount := 1
 //
 //	tx, err := contract.SetAllowance(context.Background(), spender, amount)
-func (erc20 *ERC20) SetAllowance(ctx context.Context, spender string, amount float64) (*types.Transaction, error) {
+func (erc20 *ERC20) SetAllowance(ctx context.Context, spender string, amount float64) (*TransactionResult, error) {
+	tx, err := erc20.PrepareSetAllowance(ctx, spender, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare setting the allowance of a wallet to spend the connected wallets funds without sending it.
+//
+// spender: wallet address to set the allowance of
+//
+// amount: amount of tokens to grant the spender allowance of
+//
+// returns: the prepared allowance transaction
+func (erc20 *ERC20) PrepareSetAllowance(ctx context.Context, spender string, amount float64) (*PreparedTx, error) {
 	amountWithDecimals, err := erc20.normalizeAmount(ctx, amount)
 	if err != nil {
 		return nil, err
 	}
 
-	txOpts, err := erc20.helper.GetTxOptions(ctx)
+	return erc20.PrepareSetAllowanceBigInt(ctx, spender, amountWithDecimals)
+}
+
+// Set the allowance of a wallet to spend the connected wallets funds, with an exact decimal amount
+// like "1.5".
+//
+// spender: wallet address to set the allowance of
+//
+// amount: exact decimal amount of tokens, with at most as many decimals as the token
+//
+// returns: the transaction receipt
+//
+// Example
+//
+//	tx, err := contract.SetAllowanceString(context.Background(), "0x...", "0.000000000000000001")
+func (erc20 *ERC20) SetAllowanceString(ctx context.Context, spender string, amount string) (*TransactionResult, error) {
+	tx, err := erc20.PrepareSetAllowanceString(ctx, spender, amount)
 	if err != nil {
 		return nil, err
 	}
-	tx, err := erc20.abi.Approve(txOpts, common.HexToAddress(spender), amountWithDecimals)
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare setting the allowance of a wallet to spend the connected wallets funds with an exact
+// decimal amount without sending it.
+//
+// spender: wallet address to set the allowance of
+//
+// amount: exact decimal amount of tokens, with at most as many decimals as the token
+//
+// returns: the prepared transaction
+func (erc20 *ERC20) PrepareSetAllowanceString(ctx context.Context, spender string, amount string) (*PreparedTx, error) {
+	amountWithDecimals, err := erc20.normalizeAmountString(ctx, amount)
 	if err != nil {
 		return nil, err
 	}
 
-	return erc20.helper.AwaitTx(ctx, tx.Hash())
+	return erc20.PrepareSetAllowanceBigInt(ctx, spender, amountWithDecimals)
+}
+
+// Set the allowance of a wallet to spend the connected wallets funds, with an amount in the
+// smallest unit of the token, like wei for a token with 18 decimals.
+//
+// spender: wallet address to set the allowance of
+//
+// amount: amount of tokens in the smallest unit of the token
+//
+// returns: the transaction receipt
+//
+// Example
+//
+//	amount, _ := new(big.Int).SetString("1500000000000000000", 10)
+//	tx, err := contract.SetAllowanceBigInt(context.Background(), "0x...", amount)
+func (erc20 *ERC20) SetAllowanceBigInt(ctx context.Context, spender string, amount *big.Int) (*TransactionResult, error) {
+	tx, err := erc20.PrepareSetAllowanceBigInt(ctx, spender, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare setting the allowance of a wallet to spend the connected wallets funds with an amount in
+// the smallest unit of the token without sending it.
+//
+// spender: wallet address to set the allowance of
+//
+// amount: amount of tokens in the smallest unit of the token
+//
+// returns: the prepared transaction
+func (erc20 *ERC20) PrepareSetAllowanceBigInt(ctx context.Context, spender string, amount *big.Int) (*PreparedTx, error) {
+	return newPreparedTx(erc20.helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return erc20.abi.Approve(opts, common.HexToAddress(spender), amount)
+	}), nil
 }
 
 // Transfer tokens from the connected wallet to many wallets.
@@ -249,65 +512,138 @@ func (erc20 *ERC20) SetAllowance(ctx context.Context, spender string, amount flo
 //	}
 //
 //	tx, err := contract.TransferBatch(context.Background(), args)
-func (erc20 *ERC20) TransferBatch(ctx context.Context, args []*TokenAmount) (*types.Transaction, error) {
+func (erc20 *ERC20) TransferBatch(ctx context.Context, args []*TokenAmount) (*TransactionResult, error) {
+	tx, err := erc20.PrepareTransferBatch(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the transfer of tokens from the connected wallet to many wallets without sending it.
+//
+// args: list of token amounts with amounts and addresses to transfer to
+//
+// returns: the prepared transaction of the transfers
+func (erc20 *ERC20) PrepareTransferBatch(ctx context.Context, args []*TokenAmount) (*PreparedTx, error) {
 	encoded := [][]byte{}
 
 	for _, arg := range args {
-		amountWithDecimals, err := erc20.normalizeAmount(ctx, arg.Amount)
+		amountWithDecimals, err := erc20.normalizeTokenAmount(ctx, arg)
 		if err != nil {
 			return nil, err
 		}
 
-		txOpts, err := erc20.helper.getEncodedTxOptions(ctx)
-		if err != nil {
-			return nil, err
-		}
-		tx, err := erc20.abi.Transfer(txOpts, common.HexToAddress(arg.ToAddress), amountWithDecimals)
+		data, err := encodeCallData(abi.TokenERC20MetaData, "transfer", common.HexToAddress(arg.ToAddress), amountWithDecimals)
 		if err != nil {
 			return nil, err
 		}
 
-		encoded = append(encoded, tx.Data())
+		encoded = append(encoded, data)
 	}
 
-	txOpts, err := erc20.helper.GetTxOptions(ctx)
+	return newPreparedTx(erc20.helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return erc20.abi.Multicall(opts, encoded)
+	}), nil
+}
+
+// Burn a specified amount of tokens from the connected wallet.
+//
+// amount: amount of tokens to burn
+//
+// returns: transaction receipt of the burn
+//
+// Example
+//
+//	amount := 1
+//	tx, err := contract.Burn(context.Background(), amount)
+func (erc20 *ERC20) Burn(ctx context.Context, amount float64) (*TransactionResult, error) {
+	tx, err := erc20.PrepareBurn(ctx, amount)
 	if err != nil {
 		return nil, err
 	}
-	tx, err := erc20.abi.Multicall(txOpts, encoded)
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the burn of a specified amount of tokens from the connected wallet without sending it.
+//
+// amount: amount of tokens to burn
+//
+// returns: the prepared burn transaction
+func (erc20 *ERC20) PrepareBurn(ctx context.Context, amount float64) (*PreparedTx, error) {
+	amountWithDecimals, err := erc20.normalizeAmount(ctx, amount)
 	if err != nil {
 		return nil, err
 	}
 
-	return erc20.helper.AwaitTx(ctx, tx.Hash())
+	return erc20.PrepareBurnBigInt(ctx, amountWithDecimals)
 }
 
-// Burn a specified amount of tokens from the connected wallet.
+// Burn tokens from the connected wallet, with an exact decimal amount like "1.5".
 //
-// amount: amount of tokens to burn
+// amount: exact decimal amount of tokens, with at most as many decimals as the token
 //
-// returns: transaction receipt of the burn
+// returns: the transaction receipt
 //
 // Example
 //
-//	amount := 1
-//	tx, err := contract.Burn(context.Background(), amount)
-func (erc20 *ERC20) Burn(ctx context.Context, amount float64) (*types.Transaction, error) {
-	amountWithDecimals, err := erc20.normalizeAmount(ctx, amount)
+//	tx, err := contract.BurnString(context.Background(), "0.000000000000000001")
+func (erc20 *ERC20) BurnString(ctx context.Context, amount string) (*TransactionResult, error) {
+	tx, err := erc20.PrepareBurnString(ctx, amount)
 	if err != nil {
 		return nil, err
 	}
 
-	txOpts, err := erc20.helper.GetTxOptions(ctx)
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the burn of tokens from the connected wallet with an exact decimal amount without sending
+// it.
+//
+// amount: exact decimal amount of tokens, with at most as many decimals as the token
+//
+// returns: the prepared transaction
+func (erc20 *ERC20) PrepareBurnString(ctx context.Context, amount string) (*PreparedTx, error) {
+	amountWithDecimals, err := erc20.normalizeAmountString(ctx, amount)
 	if err != nil {
 		return nil, err
 	}
-	tx, err := erc20.abi.Burn(txOpts, amountWithDecimals)
+
+	return erc20.PrepareBurnBigInt(ctx, amountWithDecimals)
+}
+
+// Burn tokens from the connected wallet, with an amount in the smallest unit of the token, like wei
+// for a token with 18 decimals.
+//
+// amount: amount of tokens in the smallest unit of the token
+//
+// returns: the transaction receipt
+//
+// Example
+//
+//	amount, _ := new(big.Int).SetString("1500000000000000000", 10)
+//	tx, err := contract.BurnBigInt(context.Background(), amount)
+func (erc20 *ERC20) BurnBigInt(ctx context.Context, amount *big.Int) (*TransactionResult, error) {
+	tx, err := erc20.PrepareBurnBigInt(ctx, amount)
 	if err != nil {
 		return nil, err
 	}
 
-	return erc20.helper.AwaitTx(ctx, tx.Hash())
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the burn of tokens from the connected wallet with an amount in the smallest unit of the
+// token without sending it.
+//
+// amount: amount of tokens in the smallest unit of the token
+//
+// returns: the prepared transaction
+func (erc20 *ERC20) PrepareBurnBigInt(ctx context.Context, amount *big.Int) (*PreparedTx, error) {
+	return newPreparedTx(erc20.helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return erc20.abi.Burn(opts, amount)
+	}), nil
 }
 
 // Burn a specified amount of tokens from a specific wallet.
@@ -324,28 +660,108 @@ func (erc20 *ERC20) Burn(ctx context.Context, amount float64) (*types.Transactio
 //	amount := 1
 //
 //	tx, err := contract.BurnFrom(context.Background(), holder, amount)
-func (erc20 *ERC20) BurnFrom(ctx context.Context, holder string, amount float64) (*types.Transaction, error) {
+func (erc20 *ERC20) BurnFrom(ctx context.Context, holder string, amount float64) (*TransactionResult, error) {
+	tx, err := erc20.PrepareBurnFrom(ctx, holder, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the burn of a specified amount of tokens from a specific wallet without sending it.
+//
+// holder: wallet address to burn the tokens from
+//
+// amount: amount of tokens to burn
+//
+// returns: the prepared burn transaction
+func (erc20 *ERC20) PrepareBurnFrom(ctx context.Context, holder string, amount float64) (*PreparedTx, error) {
 	amountWithDecimals, err := erc20.normalizeAmount(ctx, amount)
 	if err != nil {
 		return nil, err
 	}
 
-	txOpts, err := erc20.helper.GetTxOptions(ctx)
+	return erc20.PrepareBurnFromBigInt(ctx, holder, amountWithDecimals)
+}
+
+// Burn tokens from a specific wallet, with an exact decimal amount like "1.5".
+//
+// holder: wallet address to burn the tokens from
+//
+// amount: exact decimal amount of tokens, with at most as many decimals as the token
+//
+// returns: the transaction receipt
+//
+// Example
+//
+//	tx, err := contract.BurnFromString(context.Background(), "0x...", "0.000000000000000001")
+func (erc20 *ERC20) BurnFromString(ctx context.Context, holder string, amount string) (*TransactionResult, error) {
+	tx, err := erc20.PrepareBurnFromString(ctx, holder, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the burn of tokens from a specific wallet with an exact decimal amount without sending
+// it.
+//
+// holder: wallet address to burn the tokens from
+//
+// amount: exact decimal amount of tokens, with at most as many decimals as the token
+//
+// returns: the prepared transaction
+func (erc20 *ERC20) PrepareBurnFromString(ctx context.Context, holder string, amount string) (*PreparedTx, error) {
+	amountWithDecimals, err := erc20.normalizeAmountString(ctx, amount)
 	if err != nil {
 		return nil, err
 	}
-	tx, err := erc20.abi.BurnFrom(txOpts, common.HexToAddress(holder), amountWithDecimals)
+
+	return erc20.PrepareBurnFromBigInt(ctx, holder, amountWithDecimals)
+}
+
+// Burn tokens from a specific wallet, with an amount in the smallest unit of the token, like wei
+// for a token with 18 decimals.
+//
+// holder: wallet address to burn the tokens from
+//
+// amount: amount of tokens in the smallest unit of the token
+//
+// returns: the transaction receipt
+//
+// Example
+//
+//	amount, _ := new(big.Int).SetString("1500000000000000000", 10)
+//	tx, err := contract.BurnFromBigInt(context.Background(), "0x...", amount)
+func (erc20 *ERC20) BurnFromBigInt(ctx context.Context, holder string, amount *big.Int) (*TransactionResult, error) {
+	tx, err := erc20.PrepareBurnFromBigInt(ctx, holder, amount)
 	if err != nil {
 		return nil, err
 	}
 
-	return erc20.helper.AwaitTx(ctx, tx.Hash())
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the burn of tokens from a specific wallet with an amount in the smallest unit of the
+// token without sending it.
+//
+// holder: wallet address to burn the tokens from
+//
+// amount: amount of tokens in the smallest unit of the token
+//
+// returns: the prepared transaction
+func (erc20 *ERC20) PrepareBurnFromBigInt(ctx context.Context, holder string, amount *big.Int) (*PreparedTx, error) {
+	return newPreparedTx(erc20.helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return erc20.abi.BurnFrom(opts, common.HexToAddress(holder), amount)
+	}), nil
 }
 
 func (erc20 *ERC20) getValue(ctx context.Context, value *big.Int) (*CurrencyValue, error) {
 	return fetchCurrencyValue(
 		ctx,
-		erc20.helper.GetProvider(),
+		erc20.helper.getContractBackend(),
 		erc20.helper.getAddress().String(),
 		value,
 	)
@@ -359,3 +775,26 @@ func (erc20 *ERC20) normalizeAmount(ctx context.Context, amount float64) (*big.I
 
 	return parseUnits(amount, currency.Decimals)
 }
+
+func (erc20 *ERC20) normalizeAmountString(ctx context.Context, amount string) (*big.Int, error) {
+	currency, err := erc20.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseUnitsString(amount, currency.Decimals)
+}
+
+// Get the amount of a token amount with the token decimals, from its big.Int, string or float amount
+func (erc20 *ERC20) normalizeTokenAmount(ctx context.Context, tokenAmount *TokenAmount) (*big.Int, error) {
+	if tokenAmount.AmountBigInt != nil {
+		return normalizeAmountValue(tokenAmount.Amount, tokenAmount.AmountString, tokenAmount.AmountBigInt, 0)
+	}
+
+	currency, err := erc20.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return normalizeAmountValue(tokenAmount.Amount, tokenAmount.AmountString, tokenAmount.AmountBigInt, currency.Decimals)
+}
@@ -2,9 +2,7 @@ package web3sdks
 
 import (
 	"context"
-	"fmt"
 	"math/big"
-	"sort"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
@@ -20,6 +18,7 @@ type ERC721 struct {
 	abi     *abi.TokenERC721
 	helper  *contractHelper
 	storage storage
+	events  *ContractEvents
 }
 
 type NFTResult struct {
@@ -27,16 +26,19 @@ type NFTResult struct {
 	err error
 }
 
-func newERC721(provider *ethclient.Client, address common.Address, privateKey string, storage storage) (*ERC721, error) {
-	if contractAbi, err := abi.NewTokenERC721(address, provider); err != nil {
+func newERC721(provider *ethclient.Client, address common.Address, handler *ProviderHandler, storage storage) (*ERC721, error) {
+	if contractAbi, err := abi.NewTokenERC721(address, handler.getContractBackend()); err != nil {
 		return nil, err
-	} else if helper, err := newContractHelper(address, provider, privateKey); err != nil {
+	} else if helper, err := newContractHelper(address, handler); err != nil {
+		return nil, err
+	} else if events, err := newContractEvents(abi.TokenERC721ABI, helper); err != nil {
 		return nil, err
 	} else {
 		return &ERC721{
 			contractAbi,
 			helper,
 			storage,
+			events,
 		}, nil
 	}
 }
@@ -71,25 +73,101 @@ func (erc721 *ERC721) Get(ctx context.Context, tokenId int) (*NFTMetadataOwner,
 
 // Get the metadata of all the NFTs on this contract.
 //
-// returns: the metadata of all the NFTs on this contract
+// params: optional page of the NFTs to get, all the NFTs are returned by default
+//
+// returns: the metadata of all the NFTs on this contract. If some of the NFTs
+// can't be fetched, the others are returned along with a *PartialResultError
 //
 // Example
 //
 //	nfts, err := contract.GetAll(context.Background())
 //	ownerOne := nfts[0].Owner
 //	nameOne := nfts[0].Metadata.Name
-func (erc721 *ERC721) GetAll(ctx context.Context) ([]*NFTMetadataOwner, error) {
+//
+//	// get the NFTs 100 to 149
+//	page, err := contract.GetAll(context.Background(), &web3sdks.QueryAllParams{Start: 100, Count: 50})
+func (erc721 *ERC721) GetAll(ctx context.Context, params ...*QueryAllParams) ([]*NFTMetadataOwner, error) {
 	if totalCount, err := erc721.GetTotalCount(ctx); err != nil {
 		return nil, err
 	} else {
-		tokenIds := []*big.Int{}
-		for i := 0; i < totalCount; i++ {
-			tokenIds = append(tokenIds, big.NewInt(int64(i)))
+		tokenIds, err := getPageTokenIds(0, totalCount, params)
+		if err != nil {
+			return nil, err
 		}
 		return erc721.fetchNFTsByTokenId(ctx, tokenIds)
 	}
 }
 
+// Iterate over all the NFTs on this contract, fetching them page by page without holding the
+// whole collection in memory.
+//
+// pageSize: the number of NFTs fetched by every call to Next, defaults to 100
+//
+// returns: the iterator over the NFTs that exist when it is created
+//
+// Example
+//
+//	iterator, err := contract.Iterate(context.Background(), 500)
+//	for iterator.HasNext() {
+//		nfts, err := iterator.Next(context.Background())
+//		...
+//	}
+func (erc721 *ERC721) Iterate(ctx context.Context, pageSize int) (*NFTIterator, error) {
+	totalCount, err := erc721.GetTotalCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NFTIterator{erc721: erc721, pages: newTokenIdPages(0, totalCount, pageSize)}, nil
+}
+
+// Get the history of an NFT, from its Transfer events.
+//
+// tokenId: the token ID of the NFT to get the history of
+//
+// options: optional options of the query of the Transfer events, like the block range, the order
+// or the limit. The filters are ignored
+//
+// returns: the mint, transfers and burn of the NFT with their block timestamps, from the oldest to
+// the most recent by default
+//
+// Example
+//
+//	history, err := contract.GetHistory(context.Background(), 42)
+//	for _, activity := range history {
+//		fmt.Println(activity.Type, activity.From, activity.To, activity.Timestamp)
+//	}
+func (erc721 *ERC721) GetHistory(ctx context.Context, tokenId int, options ...*EventQueryOptions) ([]*TokenActivity, error) {
+	queries := []tokenActivityQuery{
+		{eventName: "Transfer", filters: map[string]interface{}{"tokenId": big.NewInt(int64(tokenId))}},
+	}
+
+	return erc721.getActivity(ctx, queries, getTokenActivityOptions(options))
+}
+
+// Get the activity of a wallet on this contract, from the Transfer events.
+//
+// address: the address of the wallet to get the activity of
+//
+// options: optional options of the query of the Transfer events, like the block range, the order
+// or the limit. The filters are ignored
+//
+// returns: the NFTs minted to, transferred from or to, and burned by the wallet with their block
+// timestamps, from the oldest to the most recent by default
+//
+// Example
+//
+//	activity, err := contract.GetActivity(context.Background(), "{{wallet_address}}")
+func (erc721 *ERC721) GetActivity(ctx context.Context, address string, options ...*EventQueryOptions) ([]*TokenActivity, error) {
+	wallet := common.HexToAddress(address)
+	queries := []tokenActivityQuery{
+		{eventName: "Transfer", filters: map[string]interface{}{"from": wallet}},
+		{eventName: "Transfer", filters: map[string]interface{}{"to": wallet}},
+	}
+
+	return erc721.getActivity(ctx, queries, getTokenActivityOptions(options))
+}
+
 // Get the total number of NFTs on this contract.
 //
 // returns: the total number of NFTs on this contract
@@ -184,16 +262,32 @@ func (erc721 *ERC721) IsApproved(ctx context.Context, address string, operator s
 //	tokenId := 0
 //
 //	tx, err := contract.Transfer(context.Background(), to, tokenId)
-func (erc721 *ERC721) Transfer(ctx context.Context, to string, tokenId int) (*types.Transaction, error) {
-	txOpts, err := erc721.helper.GetTxOptions(ctx)
+func (erc721 *ERC721) Transfer(ctx context.Context, to string, tokenId int) (*TransactionResult, error) {
+	tx, err := erc721.PrepareTransfer(ctx, to, tokenId)
 	if err != nil {
 		return nil, err
 	}
-	if tx, err := erc721.abi.SafeTransferFrom(txOpts, erc721.helper.GetSignerAddress(), common.HexToAddress(to), big.NewInt(int64(tokenId))); err != nil {
-		return nil, err
-	} else {
-		return erc721.helper.AwaitTx(ctx, tx.Hash())
-	}
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the transfer of a specified token from the connected wallet to a specified address
+// without sending it.
+//
+// to: wallet address to transfer the tokens to
+//
+// tokenId: the token ID of the NFT to transfer
+//
+// returns: the prepared transfer transaction
+//
+// Example
+//
+//	tx, err := contract.PrepareTransfer(context.Background(), "0x...", 0)
+//	estimate, err := tx.Estimate(context.Background())
+func (erc721 *ERC721) PrepareTransfer(ctx context.Context, to string, tokenId int) (*PreparedTx, error) {
+	return newPreparedTx(erc721.helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return erc721.abi.SafeTransferFrom(opts, opts.From, common.HexToAddress(to), big.NewInt(int64(tokenId)))
+	}), nil
 }
 
 // Burn a specified NFT from the connected wallet.
@@ -206,16 +300,24 @@ func (erc721 *ERC721) Transfer(ctx context.Context, to string, tokenId int) (*ty
 //
 //	tokenId := 0
 //	tx, err := contract.Burn(context.Background(), tokenId)
-func (erc721 *ERC721) Burn(ctx context.Context, tokenId int) (*types.Transaction, error) {
-	txOpts, err := erc721.helper.GetTxOptions(ctx)
+func (erc721 *ERC721) Burn(ctx context.Context, tokenId int) (*TransactionResult, error) {
+	tx, err := erc721.PrepareBurn(ctx, tokenId)
 	if err != nil {
 		return nil, err
 	}
-	if tx, err := erc721.abi.Burn(txOpts, big.NewInt(int64(tokenId))); err != nil {
-		return nil, err
-	} else {
-		return erc721.helper.AwaitTx(ctx, tx.Hash())
-	}
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the burn of a specified NFT from the connected wallet without sending it.
+//
+// tokenId: tokenID of the token to burn
+//
+// returns: the prepared burn transaction
+func (erc721 *ERC721) PrepareBurn(ctx context.Context, tokenId int) (*PreparedTx, error) {
+	return newPreparedTx(erc721.helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return erc721.abi.Burn(opts, big.NewInt(int64(tokenId)))
+	}), nil
 }
 
 // Set the approval for all operations of a specific address's assets.
@@ -227,16 +329,27 @@ func (erc721 *ERC721) Burn(ctx context.Context, tokenId int) (*types.Transaction
 // approved: true if the operator is approved for all operations of the assets, otherwise false
 //
 // returns: the transaction receipt of the approval
-func (erc721 *ERC721) SetApprovalForAll(ctx context.Context, operator string, approved bool) (*types.Transaction, error) {
-	txOpts, err := erc721.helper.GetTxOptions(ctx)
+func (erc721 *ERC721) SetApprovalForAll(ctx context.Context, operator string, approved bool) (*TransactionResult, error) {
+	tx, err := erc721.PrepareSetApprovalForAll(ctx, operator, approved)
 	if err != nil {
 		return nil, err
 	}
-	if tx, err := erc721.abi.SetApprovalForAll(txOpts, common.HexToAddress(operator), approved); err != nil {
-		return nil, err
-	} else {
-		return erc721.helper.AwaitTx(ctx, tx.Hash())
-	}
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare setting the approval of an operator for all the assets of the connected wallet
+// without sending it.
+//
+// operator: the address of the operator to set the approval for
+//
+// approved: true if the operator is approved for all operations of the assets, otherwise false
+//
+// returns: the prepared approval transaction
+func (erc721 *ERC721) PrepareSetApprovalForAll(ctx context.Context, operator string, approved bool) (*PreparedTx, error) {
+	return newPreparedTx(erc721.helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return erc721.abi.SetApprovalForAll(opts, common.HexToAddress(operator), approved)
+	}), nil
 }
 
 // Approve an operator for the NFT owner, which allows the operator to call transferFrom or
@@ -247,16 +360,26 @@ func (erc721 *ERC721) SetApprovalForAll(ctx context.Context, operator string, ap
 // tokenId: the token ID of the NFT to approve
 //
 // returns: the transaction receipt of the approval
-func (erc721 *ERC721) SetApprovalForToken(ctx context.Context, operator string, tokenId int) (*types.Transaction, error) {
-	txOpts, err := erc721.helper.GetTxOptions(ctx)
+func (erc721 *ERC721) SetApprovalForToken(ctx context.Context, operator string, tokenId int) (*TransactionResult, error) {
+	tx, err := erc721.PrepareSetApprovalForToken(ctx, operator, tokenId)
 	if err != nil {
 		return nil, err
 	}
-	if tx, err := erc721.abi.Approve(txOpts, common.HexToAddress(operator), big.NewInt(int64(tokenId))); err != nil {
-		return nil, err
-	} else {
-		return erc721.helper.AwaitTx(ctx, tx.Hash())
-	}
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the approval of an operator for a specified token without sending it.
+//
+// operator: the address of the operator to approve
+//
+// tokenId: the token ID of the NFT to approve
+//
+// returns: the prepared approval transaction
+func (erc721 *ERC721) PrepareSetApprovalForToken(ctx context.Context, operator string, tokenId int) (*PreparedTx, error) {
+	return newPreparedTx(erc721.helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return erc721.abi.Approve(opts, common.HexToAddress(operator), big.NewInt(int64(tokenId)))
+	}), nil
 }
 
 func (erc721 *ERC721) getTokenMetadata(ctx context.Context, tokenId int) (*NFTMetadata, error) {
@@ -276,33 +399,99 @@ func (erc721 *ERC721) getTokenMetadata(ctx context.Context, tokenId int) (*NFTMe
 func (erc721 *ERC721) fetchNFTsByTokenId(ctx context.Context, tokenIds []*big.Int) ([]*NFTMetadataOwner, error) {
 	total := len(tokenIds)
 
-	ch := make(chan *NFTResult)
-	// fetch all nfts in parallel
-	for i := 0; i < total; i++ {
-		go func(id int) {
-			if nft, err := erc721.Get(ctx, id); err == nil {
-				ch <- &NFTResult{nft, nil}
-			} else {
-				fmt.Println(err)
-				ch <- &NFTResult{nil, err}
+	contractAbi, err := abi.TokenERC721MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	// read the uris and owners of all nfts in as few requests as possible
+	reads := []*contractRead{}
+	for _, tokenId := range tokenIds {
+		for _, method := range []string{"tokenURI", "ownerOf"} {
+			read, err := newContractRead(erc721.helper.getAddress(), contractAbi, method, tokenId)
+			if err != nil {
+				return nil, err
 			}
-		}(i)
+			reads = append(reads, read)
+		}
 	}
-	// wait for all goroutines to emit
-	results := make([]*NFTResult, total)
-	for i := range results {
-		results[i] = <-ch
+	readResults := erc721.helper.batchRead(ctx, reads)
+
+	// fetch the metadata of all nfts from a bounded pool of workers
+	nfts := make([]*NFTMetadataOwner, total)
+	errs := make([]error, total)
+	forEachInParallel(ctx, total, erc721.helper.getFetchConcurrency(), func(i int) {
+		nfts[i], errs[i] = erc721.fetchNFT(ctx, tokenIds[i], readResults[2*i], readResults[2*i+1])
+	})
+	if err := ctx.Err(); err != nil {
+		return nil, err
 	}
-	// filter out errors
-	nfts := []*NFTMetadataOwner{}
-	for _, res := range results {
-		if res.nft != nil {
-			nfts = append(nfts, res.nft)
+
+	// keep the order of the token ids, leaving out the failed nfts
+	fetched := []*NFTMetadataOwner{}
+	for i, nft := range nfts {
+		if errs[i] == nil {
+			fetched = append(fetched, nft)
 		}
 	}
-	// Sort by ID
-	sort.SliceStable(nfts, func(i, j int) bool {
-		return nfts[i].Metadata.Id.Cmp(nfts[j].Metadata.Id) < 0
-	})
-	return nfts, nil
+
+	return fetched, newPartialResultError(tokenIds, errs)
+}
+
+// Fetch the metadata of NFTs without their owners, like the unclaimed NFTs of a drop which have
+// no owner yet
+func (erc721 *ERC721) fetchNFTMetadatasByTokenId(ctx context.Context, tokenIds []*big.Int) ([]*NFTMetadata, error) {
+	nfts, err := erc721.fetchNFTsByTokenId(ctx, tokenIds)
+	if nfts == nil {
+		return nil, err
+	}
+
+	metadatas := []*NFTMetadata{}
+	for _, nft := range nfts {
+		metadatas = append(metadatas, nft.Metadata)
+	}
+
+	return metadatas, err
+}
+
+// Fetch the metadata of an NFT from the batched reads of its uri and owner
+func (erc721 *ERC721) fetchNFT(ctx context.Context, tokenId *big.Int, uriResult *readResult, ownerResult *readResult) (*NFTMetadataOwner, error) {
+	owner := zeroAddress
+	address := common.Address{}
+	if err := ownerResult.unpackInto(&address); err == nil {
+		owner = address.String()
+	}
+
+	uri := ""
+	if err := uriResult.unpackInto(&uri); err != nil {
+		return nil, err
+	}
+
+	metadata, err := fetchTokenMetadata(ctx, int(tokenId.Int64()), uri, erc721.storage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NFTMetadataOwner{
+		Metadata: metadata,
+		Owner:    owner,
+	}, nil
+}
+
+func (erc721 *ERC721) getActivity(ctx context.Context, queries []tokenActivityQuery, options EventQueryOptions) ([]*TokenActivity, error) {
+	events, err := getTokenActivityEvents(ctx, erc721.events, queries, options)
+	if err != nil {
+		return nil, err
+	}
+
+	activities := []*TokenActivity{}
+	for _, event := range events {
+		activities = append(activities, newTokenActivity(event, event.Data["tokenId"].(*big.Int), big.NewInt(1)))
+	}
+
+	if err := setTokenActivityTimestamps(ctx, erc721.helper, activities); err != nil {
+		return nil, err
+	}
+
+	return activities, nil
 }
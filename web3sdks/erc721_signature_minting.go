@@ -9,7 +9,6 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	signerTypes "github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/google/uuid"
@@ -25,10 +24,10 @@ type ERC721SignatureMinting struct {
 	storage storage
 }
 
-func newERC721SignatureMinting(provider *ethclient.Client, address common.Address, privateKey string, storage storage) (*ERC721SignatureMinting, error) {
-	if contractAbi, err := abi.NewTokenERC721(address, provider); err != nil {
+func newERC721SignatureMinting(provider *ethclient.Client, address common.Address, handler *ProviderHandler, storage storage) (*ERC721SignatureMinting, error) {
+	if contractAbi, err := abi.NewTokenERC721(address, handler.getContractBackend()); err != nil {
 		return nil, err
-	} else if helper, err := newContractHelper(address, provider, privateKey); err != nil {
+	} else if helper, err := newContractHelper(address, handler); err != nil {
 		return nil, err
 	} else {
 		return &ERC721SignatureMinting{
@@ -50,32 +49,34 @@ func newERC721SignatureMinting(provider *ethclient.Client, address common.Addres
 //	// Learn more about how to craft a payload in the Generate() function
 //	signedPayload, err := contract.Signature.Generate(payload)
 //	tx, err := contract.Signature.Mint(context.Background(), signedPayload)
-func (signature *ERC721SignatureMinting) Mint(ctx context.Context, signedPayload *SignedPayload721) (*types.Transaction, error) {
-	message, err := signature.mapPayloadToContractStruct(ctx, signedPayload.Payload)
+func (signature *ERC721SignatureMinting) Mint(ctx context.Context, signedPayload *SignedPayload721) (*TransactionResult, error) {
+	tx, err := signature.PrepareMint(ctx, signedPayload)
 	if err != nil {
 		return nil, err
 	}
 
-	txOpts, err := signature.helper.GetTxOptions(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if err := setErc20Allowance(
-		ctx,
-		signature.helper,
-		message.Price,
-		message.Currency.String(),
-		txOpts,
-	); err != nil {
-		return nil, err
-	}
+	return tx.SendAndWait(ctx)
+}
 
-	tx, err := signature.abi.MintWithSignature(txOpts, *message, signedPayload.Signature)
+// Prepare the mint of a token with the data in given payload without sending it.
+//
+// signedPayload: the payload signed by the minters private key being used to mint
+//
+// returns: the prepared mint transaction
+func (signature *ERC721SignatureMinting) PrepareMint(ctx context.Context, signedPayload *SignedPayload721) (*PreparedTx, error) {
+	message, err := signature.mapPayloadToContractStruct(ctx, signedPayload.Payload)
 	if err != nil {
 		return nil, err
 	}
 
-	return signature.helper.AwaitTx(ctx, tx.Hash())
+	tx := newPreparedTx(signature.helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return signature.abi.MintWithSignature(opts, *message, signedPayload.Signature)
+	})
+
+	return tx.withPayment(
+		message.Price,
+		message.Currency.String(),
+	), nil
 }
 
 // Mint a batch of token with the data in given payload.
@@ -89,45 +90,48 @@ func (signature *ERC721SignatureMinting) Mint(ctx context.Context, signedPayload
 //	// Learn more about how to craft multiple payloads in the GenerateBatch() function
 //	signedPayloads, err := contract.Signature.GenerateBatch(payloads)
 //	tx, err := contract.Signature.MintBatch(context.Background(), signedPayloads)
-func (signature *ERC721SignatureMinting) MintBatch(ctx context.Context, signedPayloads []*SignedPayload721) (*types.Transaction, error) {
+func (signature *ERC721SignatureMinting) MintBatch(ctx context.Context, signedPayloads []*SignedPayload721) (*TransactionResult, error) {
+	tx, err := signature.PrepareMintBatch(ctx, signedPayloads)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the mint of a batch of tokens with the data in given payloads without sending it.
+//
+// signedPayloads: the list of payloads signed by the minters private key being used to mint
+//
+// returns: the prepared batch mint transaction
+func (signature *ERC721SignatureMinting) PrepareMintBatch(ctx context.Context, signedPayloads []*SignedPayload721) (*PreparedTx, error) {
 	contractPayloads := []*abi.ITokenERC721MintRequest{}
 	for _, signedPayload := range signedPayloads {
-		if signedPayload.Payload.Price > 0 {
-			return nil, fmt.Errorf("Can only batch free mints. For mints with a price, use the Mint() function.")
-		}
-
 		payload, err := signature.mapPayloadToContractStruct(ctx, signedPayload.Payload)
 		if err != nil {
 			return nil, err
 		}
 
+		if payload.Price.Sign() > 0 {
+			return nil, fmt.Errorf("Can only batch free mints. For mints with a price, use the Mint() function.")
+		}
+
 		contractPayloads = append(contractPayloads, payload)
 	}
 
 	encoded := [][]byte{}
 	for i, payload := range contractPayloads {
-		txOpts, err := signature.helper.getEncodedTxOptions(ctx)
-		if err != nil {
-			return nil, err
-		}
-		tx, err := signature.abi.MintWithSignature(txOpts, *payload, signedPayloads[i].Signature)
+		data, err := encodeCallData(abi.TokenERC721MetaData, "mintWithSignature", *payload, signedPayloads[i].Signature)
 		if err != nil {
 			return nil, err
 		}
 
-		encoded = append(encoded, tx.Data())
+		encoded = append(encoded, data)
 	}
 
-	txOpts, err := signature.helper.GetTxOptions(ctx)
-	if err != nil {
-		return nil, err
-	}
-	tx, err := signature.abi.Multicall(txOpts, encoded)
-	if err != nil {
-		return nil, err
-	}
-
-	return signature.helper.AwaitTx(ctx, tx.Hash())
+	return newPreparedTx(signature.helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return signature.abi.Multicall(opts, encoded)
+	}), nil
 }
 
 // Verify that a signed payload is valid
@@ -258,6 +262,8 @@ func (signature *ERC721SignatureMinting) GenerateBatch(ctx context.Context, payl
 		payload := &Signature721PayloadOutput{
 			To:                   p.To,
 			Price:                p.Price,
+			PriceString:          p.PriceString,
+			PriceBigInt:          p.PriceBigInt,
 			CurrencyAddress:      p.CurrencyAddress,
 			MintStartTime:        p.MintStartTime,
 			MintEndTime:          p.MintEndTime,
@@ -305,21 +311,12 @@ func (signature *ERC721SignatureMinting) GenerateBatch(ctx context.Context, payl
 			Message: mappedPayload,
 		}
 
-		domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
-		if err != nil {
-			return nil, err
-		}
-
-		typedDataHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
-		if err != nil {
-			return nil, err
+		signer := signature.helper.GetSigner()
+		if signer == nil {
+			return nil, &NoSignerError{TypeName: "signature"}
 		}
 
-		rawData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator), string(typedDataHash)))
-		sigHash := crypto.Keccak256(rawData)
-
-		privateKey := signature.helper.GetPrivateKey()
-		signatureHash, err := crypto.Sign(sigHash, privateKey)
+		signatureHash, err := signer.SignTypedData(ctx, typedData)
 		if err != nil {
 			return nil, err
 		}
@@ -339,8 +336,14 @@ func (signature *ERC721SignatureMinting) GenerateBatch(ctx context.Context, payl
 }
 
 func (signature *ERC721SignatureMinting) generateMessage(ctx context.Context, mintRequest *Signature721PayloadOutput) (signerTypes.TypedDataMessage, error) {
-	provider := signature.helper.GetProvider()
-	price, err := normalizePriceValue(ctx, provider, mintRequest.Price, mintRequest.CurrencyAddress)
+	price, err := normalizePriceValue(
+		ctx,
+		signature.helper.getContractBackend(),
+		mintRequest.Price,
+		mintRequest.PriceString,
+		mintRequest.PriceBigInt,
+		mintRequest.CurrencyAddress,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -351,7 +354,7 @@ func (signature *ERC721SignatureMinting) generateMessage(ctx context.Context, mi
 		"royaltyBps":             fmt.Sprintf("%v", mintRequest.RoyaltyBps),
 		"primarySaleRecipient":   mintRequest.PrimarySaleRecipient,
 		"uri":                    mintRequest.Uri,
-		"price":                  fmt.Sprintf("%v", int(price.Int64())),
+		"price":                  price.String(),
 		"currency":               mintRequest.CurrencyAddress,
 		"validityStartTimestamp": fmt.Sprintf("%v", mintRequest.MintStartTime),
 		"validityEndTimestamp":   fmt.Sprintf("%v", mintRequest.MintEndTime),
@@ -362,8 +365,14 @@ func (signature *ERC721SignatureMinting) generateMessage(ctx context.Context, mi
 }
 
 func (signature *ERC721SignatureMinting) mapPayloadToContractStruct(ctx context.Context, mintRequest *Signature721PayloadOutput) (*abi.ITokenERC721MintRequest, error) {
-	provider := signature.helper.GetProvider()
-	price, err := normalizePriceValue(ctx, provider, mintRequest.Price, mintRequest.CurrencyAddress)
+	price, err := normalizePriceValue(
+		ctx,
+		signature.helper.getContractBackend(),
+		mintRequest.Price,
+		mintRequest.PriceString,
+		mintRequest.PriceBigInt,
+		mintRequest.CurrencyAddress,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -1,57 +1,407 @@
 package web3sdks
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
 
-type notFoundError struct {
-	identifier interface{}
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Sentinel errors that every matching error type of the SDK can be compared to with errors.Is.
+//
+// Example
+//
+//	_, err := contract.Get(context.Background(), 100)
+//	if errors.Is(err, web3sdks.ErrNotFound) {
+//		fmt.Println("NFT doesn't exist")
+//	}
+var (
+	// Matches errors returned when a token, listing or other resource doesn't exist
+	ErrNotFound = errors.New("Not found")
+	// Matches errors returned when a write method is called on an SDK without a signer
+	ErrNoSigner = errors.New("You need to set a private key or signer to use this function!")
+	// Matches errors returned when uploading to storage fails
+	ErrUploadFailed = errors.New("Upload failed")
+	// Matches errors returned when a contract call or transaction reverts
+	ErrReverted = errors.New("Execution reverted")
+)
+
+// Returned when a token, listing or other resource doesn't exist, matches ErrNotFound.
+type NotFoundError struct {
+	// The kind of resource that wasn't found, like "listing"
+	TypeName   string
+	Identifier interface{}
 }
 
-func (m *notFoundError) Error() string {
-	return fmt.Sprintf("Could not find with id %v", m.identifier)
+func (m *NotFoundError) Error() string {
+	if m.TypeName == "" {
+		return fmt.Sprintf("Could not find with id %v", m.Identifier)
+	}
+
+	return fmt.Sprintf("Could not find %v with id %v", m.TypeName, m.Identifier)
 }
 
-type unmarshalError struct {
-	body            string
-	typeName        string
+func (m *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// Returned when a response can't be unmarshaled, wraps the underlying error.
+type UnmarshalError struct {
+	Body            string
+	TypeName        string
 	UnderlyingError error
 }
 
-func (m *unmarshalError) Error() string {
-	return fmt.Sprintf("Could not unmarshal %v with body %v", m.typeName, m.body)
+func (m *UnmarshalError) Error() string {
+	return fmt.Sprintf("Could not unmarshal %v with body %v", m.TypeName, m.Body)
+}
+
+func (m *UnmarshalError) Unwrap() error {
+	return m.UnderlyingError
 }
 
-type noSignerError struct {
-	typeName string
+// Returned when a module needs a signer that the SDK doesn't have, matches ErrNoSigner.
+type NoSignerError struct {
+	TypeName string
 	Err      error
 }
 
-func (m *noSignerError) Error() string {
-	return fmt.Sprintf("Could not proceed with transaction in %v module, missing SigningMethod", m.typeName)
+func (m *NoSignerError) Error() string {
+	return fmt.Sprintf("Could not proceed with transaction in %v module, missing SigningMethod", m.TypeName)
 }
 
-type noAddressError struct {
-	typeName string
+func (m *NoSignerError) Is(target error) bool {
+	return target == ErrNoSigner
 }
 
-func (m *noAddressError) Error() string {
-	return fmt.Sprintf("Could not proceed with transaction in %v module, missing or invalid signer address", m.typeName)
+func (m *NoSignerError) Unwrap() error {
+	return m.Err
 }
 
-type unsupportedFunctionError struct {
-	typeName string
-	body     string
+// Returned when the address of the signer is missing or invalid.
+type NoAddressError struct {
+	TypeName string
 }
 
-func (m *unsupportedFunctionError) Error() string {
-	return fmt.Sprintf("The method you're executing in the %v module is not supported yet. %v", m.typeName, m.body)
+func (m *NoAddressError) Error() string {
+	return fmt.Sprintf("Could not proceed with transaction in %v module, missing or invalid signer address", m.TypeName)
 }
 
-type failedToUploadError struct {
-	statusCode      int
+// Returned when a method isn't supported by a module yet.
+type UnsupportedFunctionError struct {
+	TypeName string
+	Body     string
+}
+
+func (m *UnsupportedFunctionError) Error() string {
+	return fmt.Sprintf("The method you're executing in the %v module is not supported yet. %v", m.TypeName, m.Body)
+}
+
+// Returned when uploading to storage fails, matches ErrUploadFailed. StatusCode is 0 if the
+// upload failed before a response was received.
+type UploadFailedError struct {
+	StatusCode      int
 	Payload         interface{}
 	UnderlyingError error
 }
 
-func (m *failedToUploadError) Error() string {
-	return fmt.Sprintf("Failed to upload, status code = %d", m.statusCode)
+func (m *UploadFailedError) Error() string {
+	return fmt.Sprintf("Failed to upload, status code = %d", m.StatusCode)
+}
+
+func (m *UploadFailedError) Is(target error) bool {
+	return target == ErrUploadFailed
+}
+
+func (m *UploadFailedError) Unwrap() error {
+	return m.UnderlyingError
+}
+
+// A decoded contract revert, matches ErrReverted. Name is "Error" for reverts with a message,
+// "Panic" for failed assertions and arithmetic errors, the name of the custom error for errors
+// declared in the contract ABI, and empty if the revert data couldn't be decoded. Args holds the
+// decoded arguments of the error, and Data the raw revert data.
+//
+// Example
+//
+//	_, err := contract.Transfer(context.Background(), "{{wallet_address}}", 0)
+//
+//	var revertErr *web3sdks.RevertError
+//	if errors.As(err, &revertErr) && revertErr.Name == "TransferFromIncorrectOwner" {
+//		fmt.Println("Not the owner of the token")
+//	}
+type RevertError struct {
+	Name string
+	Args []interface{}
+	Data []byte
+}
+
+func (m *RevertError) Error() string {
+	if m.Name == "" && len(m.Data) == 0 {
+		return "Execution reverted"
+	}
+
+	return fmt.Sprintf("Execution reverted: %s", m.Reason())
+}
+
+func (m *RevertError) Is(target error) bool {
+	return target == ErrReverted
+}
+
+// Get a human readable reason for the revert: the message of Error(string) reverts, and the
+// error with its arguments otherwise
+func (m *RevertError) Reason() string {
+	switch {
+	case m.Name == "Error" && len(m.Args) == 1:
+		return fmt.Sprint(m.Args[0])
+	case m.Name == "Panic" && len(m.Args) == 1:
+		code, _ := m.Args[0].(*big.Int)
+		return fmt.Sprintf("Panic(0x%x): %s", code, getPanicDescription(code))
+	case m.Name == "":
+		if len(m.Data) == 0 {
+			return ""
+		}
+		return fmt.Sprintf("unknown error %s", hexutil.Encode(m.Data))
+	}
+
+	args := make([]string, len(m.Args))
+	for i, arg := range m.Args {
+		args[i] = fmt.Sprint(arg)
+	}
+
+	return fmt.Sprintf("%s(%s)", m.Name, strings.Join(args, ", "))
+}
+
+// Returned when a transaction was mined but reverted. Reason contains the revert reason recovered by
+// replaying the transaction at the block it was mined in, and is empty if it could not be recovered.
+type TransactionRevertedError struct {
+	Hash    common.Hash
+	Receipt *types.Receipt
+	Reason  string
+	// The decoded revert, nil if it could not be recovered
+	Revert *RevertError
+}
+
+func (m *TransactionRevertedError) Error() string {
+	if m.Reason == "" {
+		return fmt.Sprintf("Transaction %s reverted", m.Hash.String())
+	}
+
+	return fmt.Sprintf("Transaction %s reverted: %s", m.Hash.String(), m.Reason)
+}
+
+func (m *TransactionRevertedError) Is(target error) bool {
+	return target == ErrReverted
+}
+
+func (m *TransactionRevertedError) Unwrap() error {
+	if m.Revert == nil {
+		return nil
+	}
+
+	return m.Revert
+}
+
+// Returned when the fees required to send a transaction exceed the ceiling of a MaxFeeCeilingStrategy.
+type MaxFeeExceededError struct {
+	RequiredFeePerGas *big.Int
+	MaxFeePerGas      *big.Int
+}
+
+func (m *MaxFeeExceededError) Error() string {
+	return fmt.Sprintf("Required fee per gas %s exceeds the max fee per gas of %s", m.RequiredFeePerGas.String(), m.MaxFeePerGas.String())
+}
+
+// Returned when simulating a transaction reverts. Reason contains the revert reason if the contract
+// reverted with a message, and Data the raw revert data returned by the node.
+type SimulationRevertedError struct {
+	Reason string
+	Data   []byte
+	// The decoded revert
+	Revert *RevertError
+}
+
+func (m *SimulationRevertedError) Error() string {
+	if m.Reason == "" {
+		return "Transaction simulation reverted"
+	}
+
+	return fmt.Sprintf("Transaction simulation reverted: %s", m.Reason)
+}
+
+func (m *SimulationRevertedError) Is(target error) bool {
+	return target == ErrReverted
+}
+
+func (m *SimulationRevertedError) Unwrap() error {
+	if m.Revert == nil {
+		return nil
+	}
+
+	return m.Revert
+}
+
+// Returned by the methods fetching many tokens, like GetAll, when some of the tokens couldn't be
+// fetched. The tokens that were fetched are still returned along with this error, in order.
+type PartialResultError struct {
+	// The errors of the tokens that couldn't be fetched, in the order of the tokens
+	TokenErrors []*TokenError
+}
+
+func (m *PartialResultError) Error() string {
+	first := m.TokenErrors[0]
+	return fmt.Sprintf("Failed to fetch %d tokens, token %s: %v", len(m.TokenErrors), first.TokenId.String(), first.Err)
+}
+
+func (m *PartialResultError) Unwrap() error {
+	return m.TokenErrors[0]
+}
+
+// The error of a single token that couldn't be fetched.
+type TokenError struct {
+	TokenId *big.Int
+	Err     error
+}
+
+func (m *TokenError) Error() string {
+	return fmt.Sprintf("Failed to fetch token %s: %v", m.TokenId.String(), m.Err)
+}
+
+func (m *TokenError) Unwrap() error {
+	return m.Err
+}
+
+// Get the error of tokens that were fetched together, or nil if every token was fetched
+func newPartialResultError(tokenIds []*big.Int, errs []error) error {
+	partialErr := &PartialResultError{}
+	for i, err := range errs {
+		if err != nil {
+			partialErr.TokenErrors = append(partialErr.TokenErrors, &TokenError{TokenId: tokenIds[i], Err: err})
+		}
+	}
+
+	if len(partialErr.TokenErrors) == 0 {
+		return nil
+	}
+
+	return partialErr
+}
+
+// Returned by the methods getting many listings, like GetAllListings, when the assets of some of
+// the listings couldn't be fetched. All the listings are still returned along with this error, in
+// order, and the listings whose asset couldn't be fetched have a nil Asset.
+type PartialListingsError struct {
+	// The errors of the listings whose asset couldn't be fetched, in the order of the listings
+	ListingErrors []*ListingError
+}
+
+func (m *PartialListingsError) Error() string {
+	first := m.ListingErrors[0]
+	return fmt.Sprintf("Failed to fetch the assets of %d listings, listing %s: %v", len(m.ListingErrors), first.ListingId.String(), first.Err)
+}
+
+func (m *PartialListingsError) Unwrap() error {
+	return m.ListingErrors[0]
+}
+
+// The error of a single listing whose asset couldn't be fetched.
+type ListingError struct {
+	ListingId *big.Int
+	Err       error
+}
+
+func (m *ListingError) Error() string {
+	return fmt.Sprintf("Failed to fetch the asset of listing %s: %v", m.ListingId.String(), m.Err)
+}
+
+func (m *ListingError) Unwrap() error {
+	return m.Err
+}
+
+// Get the error of listings that were fetched together, or nil if every asset was fetched
+func newPartialListingsError(listingIds []*big.Int, errs []error) error {
+	partialErr := &PartialListingsError{}
+	for i, err := range errs {
+		if err != nil {
+			partialErr.ListingErrors = append(partialErr.ListingErrors, &ListingError{ListingId: listingIds[i], Err: err})
+		}
+	}
+
+	if len(partialErr.ListingErrors) == 0 {
+		return nil
+	}
+
+	return partialErr
+}
+
+// Keep the errors of the listings that are left after filtering listings fetched together, or nil
+// if none of them failed
+func filterPartialListingsError(partialErr *PartialListingsError, listings []*DirectListing) error {
+	if partialErr == nil {
+		return nil
+	}
+
+	kept := map[string]bool{}
+	for _, listing := range listings {
+		kept[listing.Id] = true
+	}
+
+	filtered := &PartialListingsError{}
+	for _, listingErr := range partialErr.ListingErrors {
+		if kept[listingErr.ListingId.String()] {
+			filtered.ListingErrors = append(filtered.ListingErrors, listingErr)
+		}
+	}
+
+	if len(filtered.ListingErrors) == 0 {
+		return nil
+	}
+
+	return filtered
+}
+
+// Returned by the methods getting many events, like GetAllEvents, when some of the logs couldn't
+// be decoded with the events of the contract ABI. The decoded events are still returned along with
+// this error, in order.
+type UndecodedLogsError struct {
+	// The errors of the logs that couldn't be decoded, in the order of the logs
+	LogErrors []*LogError
+}
+
+func (m *UndecodedLogsError) Error() string {
+	first := m.LogErrors[0]
+	return fmt.Sprintf("Failed to decode %d logs, %v", len(m.LogErrors), first)
+}
+
+func (m *UndecodedLogsError) Unwrap() error {
+	return m.LogErrors[0]
+}
+
+// The error of a single log that couldn't be decoded. Event listeners send this error for every
+// log they couldn't decode.
+type LogError struct {
+	Log types.Log
+	Err error
+}
+
+func (m *LogError) Error() string {
+	return fmt.Sprintf("Failed to decode log %d of transaction %s: %v", m.Log.Index, m.Log.TxHash.String(), m.Err)
+}
+
+func (m *LogError) Unwrap() error {
+	return m.Err
+}
+
+// Returned on the error channel of a stream for every event dropped because the event channel was
+// full.
+type DroppedEventError struct {
+	Event ContractEvent
+}
+
+func (m *DroppedEventError) Error() string {
+	return fmt.Sprintf("Dropped event %s of transaction %s", m.Event.EventName, m.Event.Transaction.TxHash.String())
 }
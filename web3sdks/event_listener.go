@@ -0,0 +1,418 @@
+package web3sdks
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	defaultEventPollInterval = 2 * time.Second
+	// Number of errors kept in the error channel of a listener until they're read
+	eventErrorBufferSize = 16
+	// Number of recent blocks whose delivered logs are checked again for reorgs
+	eventReorgWindow = 64
+)
+
+// Options of an event listener added with AddEventListener.
+type EventListenerOptions struct {
+	// How often to poll for new events when the provider doesn't support subscriptions, like HTTP
+	// providers, and how long to wait before setting up a dropped subscription again. Defaults to
+	// 2 seconds
+	PollInterval time.Duration
+	// Block to start listening from, the events emitted since this block are delivered before the
+	// new ones. Defaults to the latest block
+	FromBlock *uint64
+	// Number of blocks that must be mined on top of the block of an event before it is delivered,
+	// to avoid delivering events that are removed by a reorg. Listeners waiting for confirmations
+	// poll for the confirmed events every poll interval. Defaults to 0, delivering the events as
+	// soon as they are mined
+	Confirmations uint64
+	// Where the position of the listener is saved after every delivered event, so that a restarted
+	// listener resumes where it stopped instead of starting from the FromBlock
+	Checkpoint EventCheckpoint
+}
+
+// Position of the next event to deliver by a listener.
+type EventCursor struct {
+	BlockNumber uint64
+	LogIndex    uint
+}
+
+// Storage of the position of an event listener, so that a restarted listener resumes without
+// missing or repeating events. The position is saved after the listener function returns, so an
+// event can be delivered again if the process stops in between.
+type EventCheckpoint interface {
+	// Load the saved position, or nil if no position was saved yet
+	Load(ctx context.Context) (*EventCursor, error)
+	// Save the position of the listener, once all the events before it were delivered
+	Save(ctx context.Context, cursor EventCursor) error
+}
+
+// Listener delivering every log matching its query once, in order, whether the logs are received
+// from a subscription or by polling.
+//
+// The delivered logs of the recent blocks are kept and compared with the logs fetched again every
+// time the listener catches up, so that the logs that disappear in a reorg are delivered again as
+// removed events, and the logs added by the reorg are delivered as new events.
+type eventListener struct {
+	events        *ContractEvents
+	query         ethereum.FilterQuery
+	handle        func(log types.Log) error
+	pollInterval  time.Duration
+	fromBlock     *uint64
+	confirmations uint64
+	checkpoint    EventCheckpoint
+	done          chan bool
+	errors        chan error
+
+	// Position of the next log to deliver
+	nextBlock uint64
+	nextIndex uint
+	// Delivered logs from the window start, in the order they were delivered. All the logs of the
+	// blocks from the window start that were delivered are kept
+	windowStart   uint64
+	delivered     []types.Log
+	deliveredKeys map[eventLogKey]bool
+}
+
+func newEventListener(
+	events *ContractEvents,
+	query ethereum.FilterQuery,
+	handle func(log types.Log) error,
+	options []*EventListenerOptions,
+	done chan bool,
+	errs chan error,
+) *eventListener {
+	eventListener := &eventListener{
+		events:        events,
+		query:         query,
+		handle:        handle,
+		pollInterval:  defaultEventPollInterval,
+		done:          done,
+		errors:        errs,
+		deliveredKeys: map[eventLogKey]bool{},
+	}
+
+	if len(options) > 0 && options[0] != nil {
+		if options[0].PollInterval > 0 {
+			eventListener.pollInterval = options[0].PollInterval
+		}
+		eventListener.fromBlock = options[0].FromBlock
+		eventListener.confirmations = options[0].Confirmations
+		eventListener.checkpoint = options[0].Checkpoint
+	}
+
+	return eventListener
+}
+
+// Listen until the listener is unsubscribed or the context is done, subscribing to the logs if the
+// provider supports it and polling for them otherwise
+func (listener *eventListener) run(ctx context.Context) {
+	if err := listener.start(ctx); err != nil {
+		sendEventError(listener.errors, err)
+		return
+	}
+
+	if listener.confirmations > 0 {
+		listener.poll(ctx)
+		return
+	}
+
+	for {
+		err := listener.subscribe(ctx)
+		if err == nil {
+			return
+		}
+		if errors.Is(err, rpc.ErrNotificationsUnsupported) {
+			listener.poll(ctx)
+			return
+		}
+
+		// Subscribe again after the poll interval, catching up with the events missed in between
+		sendEventError(listener.errors, err)
+		if !listener.wait(ctx) {
+			return
+		}
+	}
+}
+
+// Set the position to start from, from the checkpoint, the from block or the latest block
+func (listener *eventListener) start(ctx context.Context) error {
+	var cursor *EventCursor
+	if listener.checkpoint != nil {
+		saved, err := listener.checkpoint.Load(ctx)
+		if err != nil {
+			return err
+		}
+		cursor = saved
+	}
+
+	if cursor == nil && listener.fromBlock != nil {
+		cursor = &EventCursor{BlockNumber: *listener.fromBlock}
+	}
+
+	if cursor == nil {
+		blockNumber, err := listener.events.helper.GetProvider().BlockNumber(ctx)
+		if err != nil {
+			return err
+		}
+		cursor = &EventCursor{BlockNumber: blockNumber}
+	}
+
+	listener.nextBlock = cursor.BlockNumber
+	listener.nextIndex = cursor.LogIndex
+	// The logs of a partially delivered block aren't known, so the window starts after it
+	listener.windowStart = cursor.BlockNumber
+	if cursor.LogIndex > 0 {
+		listener.windowStart += 1
+	}
+
+	return nil
+}
+
+// Deliver the logs from a subscription, until the listener stops or the subscription fails. Returns
+// nil once the listener stops.
+func (listener *eventListener) subscribe(ctx context.Context) error {
+	logs := make(chan types.Log)
+	subscription, err := listener.events.helper.GetProvider().SubscribeFilterLogs(ctx, listener.query, logs)
+	if err != nil {
+		return err
+	}
+	defer subscription.Unsubscribe()
+
+	// The logs emitted before the subscription started are fetched once it started, so that none
+	// are missed, and the logs received from both are only delivered once
+	if err := listener.catchUp(ctx); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-listener.done:
+			return nil
+		case <-ctx.Done():
+			return nil
+		case err := <-subscription.Err():
+			if err == nil {
+				err = errors.New("Event subscription closed")
+			}
+			return err
+		case log := <-logs:
+			if log.Removed {
+				listener.remove(log)
+			} else {
+				listener.deliver(log)
+				listener.prune(log.BlockNumber)
+			}
+			listener.save(ctx)
+		}
+	}
+}
+
+// Deliver the logs of the new blocks every poll interval, until the listener stops
+func (listener *eventListener) poll(ctx context.Context) {
+	for {
+		if err := listener.catchUp(ctx); err != nil {
+			sendEventError(listener.errors, err)
+		}
+
+		if !listener.wait(ctx) {
+			return
+		}
+	}
+}
+
+// Wait for the poll interval, returning false if the listener stopped in the meantime
+func (listener *eventListener) wait(ctx context.Context) bool {
+	timer := time.NewTimer(listener.pollInterval)
+	defer timer.Stop()
+
+	select {
+	case <-listener.done:
+		return false
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// Deliver the logs from the next block to the latest confirmed block, after checking that the
+// delivered logs of the recent blocks weren't removed by a reorg
+func (listener *eventListener) catchUp(ctx context.Context) error {
+	provider := listener.events.helper.GetProvider()
+	latestBlock, err := provider.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	if latestBlock < listener.confirmations {
+		return nil
+	}
+
+	confirmedBlock := latestBlock - listener.confirmations
+	fromBlock := listener.nextBlock
+	if listener.windowStart < fromBlock {
+		fromBlock = listener.windowStart
+	}
+	if confirmedBlock < fromBlock {
+		return nil
+	}
+
+	logs, err := listener.events.filterLogs(ctx, listener.query, EventQueryOptions{
+		FromBlock: fromBlock,
+		ToBlock:   &confirmedBlock,
+	})
+	if err != nil {
+		return err
+	}
+
+	// The delivered logs that aren't in the chain anymore were removed by a reorg, and are removed
+	// from the most recent to the oldest
+	fetched := map[eventLogKey]bool{}
+	for _, log := range logs {
+		fetched[getEventLogKey(log)] = true
+	}
+	for i := len(listener.delivered) - 1; i >= 0; i-- {
+		log := listener.delivered[i]
+		if log.BlockNumber >= fromBlock && log.BlockNumber <= confirmedBlock && !fetched[getEventLogKey(log)] {
+			listener.remove(log)
+		}
+	}
+
+	for _, log := range logs {
+		if listener.deliver(log) {
+			listener.save(ctx)
+		}
+	}
+
+	// All the logs up to the confirmed block were delivered
+	if listener.nextBlock <= confirmedBlock {
+		listener.nextBlock = confirmedBlock + 1
+		listener.nextIndex = 0
+	}
+	listener.prune(confirmedBlock)
+	listener.save(ctx)
+
+	return nil
+}
+
+// Deliver a log unless it was already delivered, returning whether it was delivered
+func (listener *eventListener) deliver(log types.Log) bool {
+	if listener.isDelivered(log) {
+		return false
+	}
+
+	listener.notify(log)
+	listener.delivered = append(listener.delivered, log)
+	listener.deliveredKeys[getEventLogKey(log)] = true
+
+	if log.BlockNumber > listener.nextBlock || (log.BlockNumber == listener.nextBlock && log.Index >= listener.nextIndex) {
+		listener.nextBlock = log.BlockNumber
+		listener.nextIndex = log.Index + 1
+	}
+
+	return true
+}
+
+// Deliver a delivered log again as a removed event
+func (listener *eventListener) remove(log types.Log) {
+	key := getEventLogKey(log)
+	if !listener.deliveredKeys[key] {
+		return
+	}
+
+	for i, delivered := range listener.delivered {
+		if getEventLogKey(delivered) == key {
+			listener.delivered = append(listener.delivered[:i], listener.delivered[i+1:]...)
+			delete(listener.deliveredKeys, key)
+
+			delivered.Removed = true
+			listener.notify(delivered)
+			return
+		}
+	}
+}
+
+func (listener *eventListener) notify(log types.Log) {
+	if err := listener.handle(log); err != nil {
+		sendEventError(listener.errors, err)
+	}
+}
+
+// Check whether a log was delivered. Every delivered log of the blocks from the window start is
+// kept, while the older blocks were delivered up to the position of the next log
+func (listener *eventListener) isDelivered(log types.Log) bool {
+	if log.BlockNumber >= listener.windowStart {
+		return listener.deliveredKeys[getEventLogKey(log)]
+	}
+
+	return log.BlockNumber < listener.nextBlock || (log.BlockNumber == listener.nextBlock && log.Index < listener.nextIndex)
+}
+
+// Stop keeping the delivered logs of the blocks that are too old to be removed by a reorg
+func (listener *eventListener) prune(latestBlock uint64) {
+	if latestBlock < eventReorgWindow {
+		return
+	}
+
+	windowStart := latestBlock - eventReorgWindow + 1
+	if windowStart <= listener.windowStart {
+		return
+	}
+	listener.windowStart = windowStart
+
+	delivered := []types.Log{}
+	for _, log := range listener.delivered {
+		if log.BlockNumber >= windowStart {
+			delivered = append(delivered, log)
+		} else {
+			delete(listener.deliveredKeys, getEventLogKey(log))
+		}
+	}
+	listener.delivered = delivered
+}
+
+func (listener *eventListener) save(ctx context.Context) {
+	if listener.checkpoint == nil {
+		return
+	}
+
+	cursor := EventCursor{BlockNumber: listener.nextBlock, LogIndex: listener.nextIndex}
+	if err := listener.checkpoint.Save(ctx, cursor); err != nil {
+		sendEventError(listener.errors, err)
+	}
+}
+
+// Identity of a log, which changes when a reorg includes it in another block
+type eventLogKey struct {
+	blockHash common.Hash
+	txHash    common.Hash
+	index     uint
+}
+
+func getEventLogKey(log types.Log) eventLogKey {
+	return eventLogKey{blockHash: log.BlockHash, txHash: log.TxHash, index: log.Index}
+}
+
+// Send an error to the error channel of a listener without blocking, dropping the oldest error if
+// the channel is full
+func sendEventError(errs chan error, err error) {
+	for {
+		select {
+		case errs <- err:
+			return
+		default:
+		}
+
+		select {
+		case <-errs:
+		default:
+		}
+	}
+}
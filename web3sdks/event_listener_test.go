@@ -0,0 +1,426 @@
+package web3sdks
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/web3sdks/go-sdk/v2/abi"
+)
+
+// Mock eth namespace of a node emitting the Transfer logs of a contract, served over websockets
+type mockLogsService struct {
+	mu            sync.Mutex
+	blockNumber   uint64
+	forks         int64
+	logs          []types.Log
+	subscriptions []*mockLogsSubscription
+	connections   []net.Conn
+}
+
+type mockLogsSubscription struct {
+	notifier     *rpc.Notifier
+	subscription *rpc.Subscription
+}
+
+type mockFilterCriteria struct {
+	FromBlock string `json:"fromBlock"`
+	ToBlock   string `json:"toBlock"`
+}
+
+func newMockLogsServer(t *testing.T, service *mockLogsService) *httptest.Server {
+	server := rpc.NewServer()
+	assert.Nil(t, server.RegisterName("eth", service))
+
+	httpServer := httptest.NewUnstartedServer(server.WebsocketHandler([]string{"*"}))
+	httpServer.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			service.mu.Lock()
+			service.connections = append(service.connections, conn)
+			service.mu.Unlock()
+		}
+	}
+	httpServer.Start()
+
+	return httpServer
+}
+
+func (service *mockLogsService) BlockNumber() hexutil.Uint64 {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	return hexutil.Uint64(service.blockNumber)
+}
+
+func (service *mockLogsService) GetBlockByNumber(number string, full bool) *types.Header {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	return mockHeader(int64(service.blockNumber), 10)
+}
+
+func (service *mockLogsService) GetLogs(criteria mockFilterCriteria) ([]types.Log, error) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	from, err := hexutil.DecodeUint64(criteria.FromBlock)
+	if err != nil {
+		return nil, err
+	}
+	to, err := hexutil.DecodeUint64(criteria.ToBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := []types.Log{}
+	for _, log := range service.logs {
+		if log.BlockNumber >= from && log.BlockNumber <= to {
+			logs = append(logs, log)
+		}
+	}
+
+	return logs, nil
+}
+
+func (service *mockLogsService) Logs(ctx context.Context, criteria mockFilterCriteria) (*rpc.Subscription, error) {
+	notifier, _ := rpc.NotifierFromContext(ctx)
+	subscription := notifier.CreateSubscription()
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	service.subscriptions = append(service.subscriptions, &mockLogsSubscription{notifier, subscription})
+
+	return subscription, nil
+}
+
+// Mine a block with a Transfer log of the given token, notifying the subscriptions
+func (service *mockLogsService) mine(t *testing.T, tokenId int64) {
+	tokenAbi, err := abi.TokenERC721MetaData.GetAbi()
+	assert.Nil(t, err)
+
+	service.mineLog(types.Log{
+		Address: common.HexToAddress(secondaryWallet),
+		Topics: []common.Hash{
+			tokenAbi.Events["Transfer"].ID,
+			common.Hash{},
+			common.HexToHash(adminWallet),
+			common.BigToHash(big.NewInt(tokenId)),
+		},
+		TxHash: common.BigToHash(big.NewInt(tokenId)),
+	})
+}
+
+// Mine a block with the given log, notifying the subscriptions
+func (service *mockLogsService) mineLog(log types.Log) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	service.blockNumber += 1
+	log.BlockNumber = service.blockNumber
+	log.BlockHash = common.BigToHash(big.NewInt(int64(service.blockNumber)*1000 + service.forks))
+	service.logs = append(service.logs, log)
+
+	for _, subscription := range service.subscriptions {
+		subscription.notifier.Notify(subscription.subscription.ID, log)
+	}
+}
+
+// Remove the blocks from the given block, to mine them again on another fork
+func (service *mockLogsService) reorg(fromBlock uint64) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	service.forks += 1
+	service.blockNumber = fromBlock - 1
+	logs := []types.Log{}
+	for _, log := range service.logs {
+		if log.BlockNumber < fromBlock {
+			logs = append(logs, log)
+		}
+	}
+	service.logs = logs
+}
+
+// Drop all the websocket connections
+func (service *mockLogsService) disconnect() {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	for _, conn := range service.connections {
+		conn.Close()
+	}
+	service.connections = nil
+	service.subscriptions = nil
+}
+
+func newTestEventListenerEvents(t *testing.T, url string) *ContractEvents {
+	client, err := rpc.Dial(url)
+	assert.Nil(t, err)
+
+	handler := NewProviderHandlerWithSigner(ethclient.NewClient(client), nil)
+	helper, err := newContractHelper(common.HexToAddress(secondaryWallet), handler)
+	assert.Nil(t, err)
+
+	events, err := newContractEvents(abi.TokenERC721ABI, helper)
+	assert.Nil(t, err)
+
+	return events
+}
+
+// Collect the token ids of the Transfer events received by a listener, prefixed with + for the
+// new events and - for the removed ones
+type transferCollector struct {
+	mu       sync.Mutex
+	tokenIds []string
+}
+
+func (collector *transferCollector) listen(event ContractEvent) {
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+
+	prefix := "+"
+	if event.Removed {
+		prefix = "-"
+	}
+	collector.tokenIds = append(collector.tokenIds, prefix+event.Data["tokenId"].(*big.Int).String())
+}
+
+func (collector *transferCollector) waitFor(t *testing.T, count int) []string {
+	for i := 0; i < 200; i++ {
+		collector.mu.Lock()
+		received := len(collector.tokenIds)
+		collector.mu.Unlock()
+		if received >= count {
+			break
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+	return append([]string{}, collector.tokenIds...)
+}
+
+// Drain the errors of a subscription, which are expected when its connection drops
+func drainEventErrors(subscription EventSubscription, done chan bool) {
+	go func() {
+		for {
+			select {
+			case <-subscription.Err():
+			case <-done:
+				return
+			}
+		}
+	}()
+}
+
+func TestEventListenerSubscribesOverWebsocket(t *testing.T) {
+	service := &mockLogsService{blockNumber: 10}
+	server := newMockLogsServer(t, service)
+	defer server.Close()
+
+	events := newTestEventListenerEvents(t, "ws"+strings.TrimPrefix(server.URL, "http"))
+
+	// Backfill from the block of the first transfer
+	service.mine(t, 0)
+	fromBlock := uint64(11)
+	collector := &transferCollector{}
+	subscription := events.AddEventListener(context.Background(), "Transfer", collector.listen, &EventListenerOptions{
+		FromBlock:    &fromBlock,
+		PollInterval: time.Millisecond * 10,
+	})
+	done := make(chan bool)
+	defer close(done)
+	drainEventErrors(subscription, done)
+
+	assert.Equal(t, []string{"+0"}, collector.waitFor(t, 1))
+
+	service.mine(t, 1)
+	assert.Equal(t, []string{"+0", "+1"}, collector.waitFor(t, 2))
+
+	// The transfer mined while disconnected is caught up once subscribed again
+	service.disconnect()
+	service.mine(t, 2)
+	assert.Equal(t, []string{"+0", "+1", "+2"}, collector.waitFor(t, 3))
+
+	subscription.Unsubscribe()
+}
+
+func newMockHttpLogsServer(t *testing.T, service *mockLogsService) *httptest.Server {
+	server := rpc.NewServer()
+	assert.Nil(t, server.RegisterName("eth", service))
+
+	return httptest.NewServer(server)
+}
+
+func TestEventListenerPollsOverHttp(t *testing.T) {
+	service := &mockLogsService{blockNumber: 10}
+	server := newMockHttpLogsServer(t, service)
+	defer server.Close()
+
+	events := newTestEventListenerEvents(t, server.URL)
+
+	fromBlock := uint64(11)
+	collector := &transferCollector{}
+	subscription := events.AddEventListener(context.Background(), "Transfer", collector.listen, &EventListenerOptions{
+		FromBlock:    &fromBlock,
+		PollInterval: time.Millisecond * 10,
+	})
+
+	service.mine(t, 0)
+	service.mine(t, 1)
+	assert.Equal(t, []string{"+0", "+1"}, collector.waitFor(t, 2))
+
+	subscription.Unsubscribe()
+}
+
+func TestEventListenerRemovesReorgedEvents(t *testing.T) {
+	service := &mockLogsService{blockNumber: 10}
+	server := newMockHttpLogsServer(t, service)
+	defer server.Close()
+
+	events := newTestEventListenerEvents(t, server.URL)
+
+	fromBlock := uint64(11)
+	collector := &transferCollector{}
+	subscription := events.AddEventListener(context.Background(), "Transfer", collector.listen, &EventListenerOptions{
+		FromBlock:    &fromBlock,
+		PollInterval: time.Millisecond * 10,
+	})
+
+	service.mine(t, 0)
+	service.mine(t, 1)
+	assert.Equal(t, []string{"+0", "+1"}, collector.waitFor(t, 2))
+
+	// The transfer of the block 12 is replaced by another one
+	service.reorg(12)
+	service.mine(t, 2)
+	assert.Equal(t, []string{"+0", "+1", "-1", "+2"}, collector.waitFor(t, 4))
+
+	subscription.Unsubscribe()
+}
+
+func TestEventListenerWaitsForConfirmations(t *testing.T) {
+	service := &mockLogsService{blockNumber: 10}
+	server := newMockHttpLogsServer(t, service)
+	defer server.Close()
+
+	events := newTestEventListenerEvents(t, server.URL)
+
+	fromBlock := uint64(11)
+	collector := &transferCollector{}
+	subscription := events.AddEventListener(context.Background(), "Transfer", collector.listen, &EventListenerOptions{
+		FromBlock:     &fromBlock,
+		PollInterval:  time.Millisecond * 10,
+		Confirmations: 2,
+	})
+
+	service.mine(t, 0)
+	service.mine(t, 1)
+	time.Sleep(time.Millisecond * 50)
+	assert.Len(t, collector.waitFor(t, 0), 0)
+
+	// The unconfirmed transfer of the block 12 never gets delivered
+	service.reorg(12)
+	service.mine(t, 2)
+	service.mine(t, 3)
+	service.mine(t, 4)
+	assert.Equal(t, []string{"+0", "+2"}, collector.waitFor(t, 2))
+
+	subscription.Unsubscribe()
+}
+
+// Checkpoint keeping the position of a listener in memory
+type memoryEventCheckpoint struct {
+	mu     sync.Mutex
+	cursor *EventCursor
+}
+
+func (checkpoint *memoryEventCheckpoint) Load(ctx context.Context) (*EventCursor, error) {
+	checkpoint.mu.Lock()
+	defer checkpoint.mu.Unlock()
+	return checkpoint.cursor, nil
+}
+
+func (checkpoint *memoryEventCheckpoint) Save(ctx context.Context, cursor EventCursor) error {
+	checkpoint.mu.Lock()
+	defer checkpoint.mu.Unlock()
+	checkpoint.cursor = &cursor
+	return nil
+}
+
+func TestEventListenerResumesFromCheckpoint(t *testing.T) {
+	service := &mockLogsService{blockNumber: 10}
+	server := newMockHttpLogsServer(t, service)
+	defer server.Close()
+
+	events := newTestEventListenerEvents(t, server.URL)
+	checkpoint := &memoryEventCheckpoint{}
+	fromBlock := uint64(11)
+	options := &EventListenerOptions{
+		FromBlock:    &fromBlock,
+		PollInterval: time.Millisecond * 10,
+		Checkpoint:   checkpoint,
+	}
+
+	collector := &transferCollector{}
+	subscription := events.AddEventListener(context.Background(), "Transfer", collector.listen, options)
+	service.mine(t, 0)
+	service.mine(t, 1)
+	assert.Equal(t, []string{"+0", "+1"}, collector.waitFor(t, 2))
+	subscription.Unsubscribe()
+
+	cursor, err := checkpoint.Load(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(13), cursor.BlockNumber)
+
+	// The restarted listener only delivers the transfers it didn't deliver yet
+	service.mine(t, 2)
+	collector = &transferCollector{}
+	subscription = events.AddEventListener(context.Background(), "Transfer", collector.listen, options)
+	assert.Equal(t, []string{"+2"}, collector.waitFor(t, 1))
+	time.Sleep(time.Millisecond * 50)
+	assert.Equal(t, []string{"+2"}, collector.waitFor(t, 1))
+	subscription.Unsubscribe()
+}
+
+func TestEventListenerListensForAllEvents(t *testing.T) {
+	service := &mockLogsService{blockNumber: 10}
+	server := newMockHttpLogsServer(t, service)
+	defer server.Close()
+
+	events := newTestEventListenerEvents(t, server.URL)
+
+	fromBlock := uint64(11)
+	collector := &transferCollector{}
+	subscription := events.AddAllEventsListener(context.Background(), nil, collector.listen, &EventListenerOptions{
+		FromBlock:    &fromBlock,
+		PollInterval: time.Millisecond * 10,
+	})
+
+	// A log that can't be decoded is reported without stopping the listener
+	service.mu.Lock()
+	service.blockNumber += 1
+	service.logs = append(service.logs, types.Log{Topics: []common.Hash{common.HexToHash("0x1234")}, BlockNumber: 11})
+	service.mu.Unlock()
+	service.mine(t, 0)
+
+	err := <-subscription.Err()
+	var logErr *LogError
+	assert.True(t, errors.As(err, &logErr))
+	assert.Equal(t, uint64(11), logErr.Log.BlockNumber)
+	assert.Equal(t, []string{"+0"}, collector.waitFor(t, 1))
+
+	subscription.Unsubscribe()
+}
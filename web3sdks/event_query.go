@@ -0,0 +1,123 @@
+package web3sdks
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Messages of the errors returned by the providers when a log query covers too many blocks or
+// returns too many results. The providers also use the -32005 "limit exceeded" code for rate
+// limits, which a smaller chunk doesn't help with, so only the message is checked
+var logsLimitErrorMessages = []string{
+	"too many results",
+	"query returned more than",
+	"block range",
+	"range too large",
+	"response size exceeded",
+	"query timeout exceeded",
+}
+
+// Fetch the logs matching the query over the block range of the options, in chunks of at most the
+// max chunk size of the options, in the order of the options and up to their limit
+func (events *ContractEvents) filterLogs(ctx context.Context, query ethereum.FilterQuery, options EventQueryOptions) ([]types.Log, error) {
+	toBlock := uint64(0)
+	if options.ToBlock != nil {
+		toBlock = *options.ToBlock
+	} else {
+		latestBlock, err := events.helper.GetProvider().BlockNumber(ctx)
+		if err != nil {
+			return nil, err
+		}
+		toBlock = latestBlock
+	}
+
+	options.ToBlock = &toBlock
+	return filterLogsInChunks(ctx, events.helper.GetProvider(), query, options)
+}
+
+// Fetch the logs matching the query from a filterer over the block range of the options, which must
+// have an end block
+func filterLogsInChunks(ctx context.Context, filterer bind.ContractFilterer, query ethereum.FilterQuery, options EventQueryOptions) ([]types.Log, error) {
+	toBlock := *options.ToBlock
+	if toBlock < options.FromBlock {
+		return []types.Log{}, nil
+	}
+
+	chunkSize := options.MaxChunkSize
+	if chunkSize == 0 || chunkSize > toBlock-options.FromBlock+1 {
+		chunkSize = toBlock - options.FromBlock + 1
+	}
+
+	logs := []types.Log{}
+	descending := options.Order == EventOrderDescending
+	// Remaining block range, from which the chunks are taken from the start or the end
+	fromBlock := options.FromBlock
+	for fromBlock <= toBlock {
+		start, end := fromBlock, toBlock
+		if descending && end-start+1 > chunkSize {
+			start = end - chunkSize + 1
+		} else if !descending && end-start+1 > chunkSize {
+			end = start + chunkSize - 1
+		}
+
+		chunk, err := filterLogsInRange(ctx, filterer, query, start, end)
+		if err != nil {
+			// Try again with a chunk half the size when the provider rejects the size of the chunk.
+			// Other errors like rate limits are returned, and retried by the RetryTransport if the
+			// SDK was created with retry options
+			if isLogsLimitError(err) && end > start {
+				chunkSize = (end - start + 1) / 2
+				continue
+			}
+			return nil, err
+		}
+
+		if descending {
+			for i := len(chunk) - 1; i >= 0; i-- {
+				logs = append(logs, chunk[i])
+			}
+		} else {
+			logs = append(logs, chunk...)
+		}
+
+		if options.Limit > 0 && len(logs) >= options.Limit {
+			return logs[:options.Limit], nil
+		}
+
+		if descending {
+			if start == fromBlock {
+				break
+			}
+			toBlock = start - 1
+		} else {
+			fromBlock = end + 1
+		}
+	}
+
+	return logs, nil
+}
+
+func filterLogsInRange(ctx context.Context, filterer bind.ContractFilterer, query ethereum.FilterQuery, fromBlock uint64, toBlock uint64) ([]types.Log, error) {
+	query.FromBlock = new(big.Int).SetUint64(fromBlock)
+	query.ToBlock = new(big.Int).SetUint64(toBlock)
+
+	return filterer.FilterLogs(ctx, query)
+}
+
+// Check whether an error is a provider rejecting a log query for covering too many blocks or
+// returning too many results
+func isLogsLimitError(err error) bool {
+	message := strings.ToLower(err.Error())
+	for _, limitMessage := range logsLimitErrorMessages {
+		if strings.Contains(message, limitMessage) {
+			return true
+		}
+	}
+
+	return false
+}
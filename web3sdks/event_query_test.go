@@ -0,0 +1,227 @@
+package web3sdks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/web3sdks/go-sdk/v2/abi"
+)
+
+// Mock a chain of 10,000 blocks with a Transfer log every 100 blocks, rejecting the log queries
+// over more than maxRange blocks. Returns the block ranges of the log queries.
+func handleMockTransferLogs(t *testing.T, server *mockRpcServer, maxRange uint64) func() [][2]uint64 {
+	tokenAbi, err := abi.TokenERC721MetaData.GetAbi()
+	assert.Nil(t, err)
+
+	mu := sync.Mutex{}
+	ranges := [][2]uint64{}
+
+	server.handle("eth_blockNumber", func(params []json.RawMessage) (interface{}, error) {
+		return hexutil.EncodeUint64(9999), nil
+	})
+	server.handle("eth_getLogs", func(params []json.RawMessage) (interface{}, error) {
+		criteria := struct {
+			FromBlock string `json:"fromBlock"`
+			ToBlock   string `json:"toBlock"`
+		}{}
+		if err := json.Unmarshal(params[0], &criteria); err != nil {
+			return nil, err
+		}
+		from, _ := hexutil.DecodeUint64(criteria.FromBlock)
+		to, _ := hexutil.DecodeUint64(criteria.ToBlock)
+
+		mu.Lock()
+		ranges = append(ranges, [2]uint64{from, to})
+		mu.Unlock()
+
+		if to-from+1 > maxRange {
+			return nil, &mockRpcError{Code: -32005, Message: "query returned more than 10000 results"}
+		}
+
+		logs := []*types.Log{}
+		for block := (from + 99) / 100 * 100; block <= to; block += 100 {
+			logs = append(logs, &types.Log{
+				Address: common.HexToAddress(secondaryWallet),
+				Topics: []common.Hash{
+					tokenAbi.Events["Transfer"].ID,
+					common.Hash{},
+					common.HexToHash(adminWallet),
+					common.BigToHash(big.NewInt(int64(block / 100))),
+				},
+				Data:        []byte{},
+				BlockNumber: block,
+			})
+		}
+
+		return logs, nil
+	})
+
+	return func() [][2]uint64 {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([][2]uint64{}, ranges...)
+	}
+}
+
+func newTestEventQueryEvents(t *testing.T, server *mockRpcServer) *ContractEvents {
+	events, err := newContractEvents(abi.TokenERC721ABI, server.helper(t))
+	assert.Nil(t, err)
+
+	return events
+}
+
+func getTransferTokenIds(events []ContractEvent) []int64 {
+	tokenIds := []int64{}
+	for _, event := range events {
+		tokenIds = append(tokenIds, event.Data["tokenId"].(*big.Int).Int64())
+	}
+
+	return tokenIds
+}
+
+func TestEventQueryHalvesRejectedChunks(t *testing.T) {
+	server := newMockRpcServer()
+	defer server.Close()
+	getRanges := handleMockTransferLogs(t, server, 1000)
+
+	events, err := newTestEventQueryEvents(t, server).GetEvents(context.Background(), "Transfer", EventQueryOptions{
+		MaxChunkSize: 2500,
+	})
+	assert.Nil(t, err)
+	assert.Len(t, events, 100)
+	assert.Equal(t, int64(0), getTransferTokenIds(events)[0])
+	assert.Equal(t, int64(99), getTransferTokenIds(events)[99])
+
+	// Two rejected chunks, then the whole range in chunks of 625 blocks
+	ranges := getRanges()
+	assert.Equal(t, [2]uint64{0, 2499}, ranges[0])
+	assert.Equal(t, [2]uint64{0, 1249}, ranges[1])
+	assert.Equal(t, [2]uint64{0, 624}, ranges[2])
+	assert.Equal(t, [2]uint64{9375, 9999}, ranges[len(ranges)-1])
+	assert.Len(t, ranges, 18)
+}
+
+func TestEventQueryDescendingWithLimit(t *testing.T) {
+	server := newMockRpcServer()
+	defer server.Close()
+	getRanges := handleMockTransferLogs(t, server, 1000)
+
+	events, err := newTestEventQueryEvents(t, server).GetEvents(context.Background(), "Transfer", EventQueryOptions{
+		FromBlock:    1000,
+		MaxChunkSize: 300,
+		Order:        EventOrderDescending,
+		Limit:        5,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{99, 98, 97, 96, 95}, getTransferTokenIds(events))
+	assert.Equal(t, [][2]uint64{{9700, 9999}, {9400, 9699}}, getRanges())
+
+	// The whole range from the oldest block, without chunks
+	toBlock := uint64(1500)
+	events, err = newTestEventQueryEvents(t, server).GetEvents(context.Background(), "Transfer", EventQueryOptions{
+		FromBlock: 1000,
+		ToBlock:   &toBlock,
+		Order:     EventOrderDescending,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{15, 14, 13, 12, 11, 10}, getTransferTokenIds(events))
+}
+
+func TestEventQueryFailsOnOtherErrors(t *testing.T) {
+	server := newMockRpcServer()
+	defer server.Close()
+	server.handle("eth_blockNumber", func(params []json.RawMessage) (interface{}, error) {
+		return hexutil.EncodeUint64(9999), nil
+	})
+	server.handle("eth_getLogs", func(params []json.RawMessage) (interface{}, error) {
+		return nil, errors.New("internal error")
+	})
+
+	_, err := newTestEventQueryEvents(t, server).GetEvents(context.Background(), "Transfer", EventQueryOptions{})
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, server.callCount("eth_getLogs"))
+}
+
+func TestEventQueryDoesNotHalveRateLimitedChunks(t *testing.T) {
+	server := newMockRpcServer()
+	defer server.Close()
+	server.handle("eth_blockNumber", func(params []json.RawMessage) (interface{}, error) {
+		return hexutil.EncodeUint64(9999), nil
+	})
+	server.handle("eth_getLogs", func(params []json.RawMessage) (interface{}, error) {
+		return nil, &mockRpcError{Code: -32005, Message: "request rate limited"}
+	})
+
+	_, err := newTestEventQueryEvents(t, server).GetEvents(context.Background(), "Transfer", EventQueryOptions{})
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, server.callCount("eth_getLogs"))
+}
+
+func TestEventQueryGetAllEvents(t *testing.T) {
+	tokenAbi, err := abi.TokenERC721MetaData.GetAbi()
+	assert.Nil(t, err)
+
+	server := newMockRpcServer()
+	defer server.Close()
+
+	var topics [][]common.Hash
+	server.handle("eth_blockNumber", func(params []json.RawMessage) (interface{}, error) {
+		return hexutil.EncodeUint64(100), nil
+	})
+	server.handle("eth_getLogs", func(params []json.RawMessage) (interface{}, error) {
+		criteria := struct {
+			Topics [][]common.Hash `json:"topics"`
+		}{}
+		if err := json.Unmarshal(params[0], &criteria); err != nil {
+			return nil, err
+		}
+		topics = criteria.Topics
+
+		indexedTopics := []common.Hash{common.HexToHash(adminWallet), common.HexToHash(secondaryWallet), common.BigToHash(big.NewInt(1))}
+		return []*types.Log{
+			{Topics: append([]common.Hash{tokenAbi.Events["Transfer"].ID}, indexedTopics...), Data: []byte{}, BlockNumber: 1},
+			{Topics: []common.Hash{common.HexToHash("0x1234")}, Data: []byte{}, BlockNumber: 2},
+			{Topics: append([]common.Hash{tokenAbi.Events["Approval"].ID}, indexedTopics...), Data: []byte{}, BlockNumber: 3},
+			{Topics: []common.Hash{}, Data: []byte{}, BlockNumber: 4},
+		}, nil
+	})
+
+	events := newTestEventQueryEvents(t, server)
+	decoded, err := events.GetAllEvents(context.Background(), nil, EventQueryOptions{})
+	assert.Len(t, topics[0], len(tokenAbi.Events))
+
+	// The decoded events are returned along with the logs that couldn't be decoded
+	assert.Len(t, decoded, 2)
+	assert.Equal(t, "Transfer", decoded[0].EventName)
+	assert.Equal(t, "Approval", decoded[1].EventName)
+	assert.Equal(t, common.HexToAddress(secondaryWallet), decoded[1].Data["approved"])
+
+	var undecodedErr *UndecodedLogsError
+	assert.True(t, errors.As(err, &undecodedErr))
+	assert.Len(t, undecodedErr.LogErrors, 2)
+	assert.Equal(t, uint64(2), undecodedErr.LogErrors[0].Log.BlockNumber)
+
+	// Only the topics of the chosen events are queried
+	_, err = events.GetAllEvents(context.Background(), []string{"Transfer", "Approval"}, EventQueryOptions{})
+	assert.NotNil(t, err)
+	assert.Equal(t, []common.Hash{tokenAbi.Events["Transfer"].ID, tokenAbi.Events["Approval"].ID}, topics[0])
+
+	_, err = events.GetAllEvents(context.Background(), []string{"Unknown"}, EventQueryOptions{})
+	assert.NotNil(t, err)
+
+	// Filters are rejected rather than ignored
+	calls := server.callCount("eth_getLogs")
+	_, err = events.GetAllEvents(context.Background(), nil, EventQueryOptions{
+		Filters: map[string]interface{}{"from": common.HexToAddress(adminWallet)},
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, calls, server.callCount("eth_getLogs"))
+}
@@ -0,0 +1,129 @@
+package web3sdks
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const defaultEventStreamBufferSize = 100
+
+// What a stream does with a new event when its channel is full.
+type EventDropPolicy int
+
+const (
+	// Wait for the channel to have room for the event, which pauses the stream until the events
+	// are read
+	EventDropPolicyBlock EventDropPolicy = iota
+	// Drop the new event, keeping the events already in the channel
+	EventDropPolicyDropNewest
+	// Drop the oldest event in the channel to make room for the new event
+	EventDropPolicyDropOldest
+)
+
+// The events to stream with Stream, and how they're delivered.
+type EventStreamFilter struct {
+	// Options of the listener streaming the events, like the block to start from or the number of
+	// confirmations
+	EventListenerOptions
+	// Names of the events to stream, all the events of the contract by default
+	EventNames []string
+	// Number of events buffered in the event channel, defaults to 100
+	BufferSize int
+	// What to do with a new event when the event channel is full, defaults to waiting for the
+	// channel to have room for it
+	DropPolicy EventDropPolicy
+}
+
+// Stream the future events of the contract to a channel, in the background, with the same options
+// as AddAllEventsListener.
+//
+// ctx: The context of the stream, which stops once the context is done
+//
+// filter: The events to stream, and how they're delivered to the channel
+//
+// returns: The channel of the events, and the channel of the errors, which keeps the most recent
+// errors when nobody reads them. Both channels are closed once the stream stops. The logs that
+// can't be decoded are sent to the error channel as a *LogError, and the dropped events as a
+// *DroppedEventError
+//
+// Example
+//
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//
+//	events, errs := contract.Events.Stream(ctx, web3sdks.EventStreamFilter{
+//	  EventNames: []string{"Transfer"},
+//	  BufferSize: 1000,
+//	  DropPolicy: web3sdks.EventDropPolicyDropOldest,
+//	})
+//
+//	for event := range events {
+//	  fmt.Printf("%#v\n", event)
+//	}
+func (events *ContractEvents) Stream(ctx context.Context, filter EventStreamFilter) (<-chan ContractEvent, <-chan error) {
+	bufferSize := filter.BufferSize
+	if bufferSize <= 0 {
+		bufferSize = defaultEventStreamBufferSize
+	}
+
+	out := make(chan ContractEvent, bufferSize)
+	errs := make(chan error, eventErrorBufferSize)
+
+	go func() {
+		defer close(out)
+		defer close(errs)
+
+		query, err := events.getEventsQuery(filter.EventNames)
+		if err != nil {
+			sendEventError(errs, err)
+			return
+		}
+
+		send := func(log types.Log) error {
+			event, err := events.decodeLog(log)
+			if err != nil {
+				return err
+			}
+
+			sendStreamEvent(ctx, out, errs, event, filter.DropPolicy)
+			return nil
+		}
+		options := filter.EventListenerOptions
+		// The stream only stops with its context
+		newEventListener(events, query, send, []*EventListenerOptions{&options}, nil, errs).run(ctx)
+	}()
+
+	return out, errs
+}
+
+// Send an event to the channel of a stream according to its drop policy
+func sendStreamEvent(ctx context.Context, out chan ContractEvent, errs chan error, event ContractEvent, policy EventDropPolicy) {
+	switch policy {
+	case EventDropPolicyDropNewest:
+		select {
+		case out <- event:
+		default:
+			sendEventError(errs, &DroppedEventError{Event: event})
+		}
+	case EventDropPolicyDropOldest:
+		for {
+			select {
+			case out <- event:
+				return
+			default:
+			}
+
+			select {
+			case dropped := <-out:
+				sendEventError(errs, &DroppedEventError{Event: dropped})
+			default:
+			}
+		}
+	default:
+		select {
+		case out <- event:
+		case <-ctx.Done():
+		}
+	}
+}
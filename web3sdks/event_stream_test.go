@@ -0,0 +1,103 @@
+package web3sdks
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func receiveTransferTokenIds(t *testing.T, events <-chan ContractEvent, count int) []int64 {
+	tokenIds := []int64{}
+	for len(tokenIds) < count {
+		select {
+		case event := <-events:
+			tokenIds = append(tokenIds, event.Data["tokenId"].(*big.Int).Int64())
+		case <-time.After(2 * time.Second):
+			t.Fatal("Timed out waiting for events")
+		}
+	}
+
+	return tokenIds
+}
+
+func TestEventStreamClosesWithContext(t *testing.T) {
+	service := &mockLogsService{blockNumber: 10}
+	server := newMockHttpLogsServer(t, service)
+	defer server.Close()
+
+	fromBlock := uint64(11)
+	ctx, cancel := context.WithCancel(context.Background())
+	events, errs := newTestEventListenerEvents(t, server.URL).Stream(ctx, EventStreamFilter{
+		EventListenerOptions: EventListenerOptions{FromBlock: &fromBlock, PollInterval: time.Millisecond * 10},
+		EventNames:           []string{"Transfer"},
+	})
+
+	service.mine(t, 0)
+	service.mine(t, 1)
+	assert.Equal(t, []int64{0, 1}, receiveTransferTokenIds(t, events, 2))
+
+	cancel()
+	for range events {
+	}
+	for range errs {
+	}
+}
+
+func TestEventStreamDropPolicies(t *testing.T) {
+	service := &mockLogsService{blockNumber: 10}
+	server := newMockHttpLogsServer(t, service)
+	defer server.Close()
+
+	for i := 0; i < 5; i++ {
+		service.mine(t, int64(i))
+	}
+
+	fromBlock := uint64(11)
+	for _, test := range []struct {
+		policy   EventDropPolicy
+		tokenIds []int64
+	}{
+		{EventDropPolicyBlock, []int64{0, 1, 2, 3, 4}},
+		{EventDropPolicyDropNewest, []int64{0, 1}},
+		{EventDropPolicyDropOldest, []int64{3, 4}},
+	} {
+		ctx, cancel := context.WithCancel(context.Background())
+		events, errs := newTestEventListenerEvents(t, server.URL).Stream(ctx, EventStreamFilter{
+			EventListenerOptions: EventListenerOptions{FromBlock: &fromBlock, PollInterval: time.Millisecond * 10},
+			BufferSize:           2,
+			DropPolicy:           test.policy,
+		})
+
+		// Let the stream fill its buffer before reading from it
+		time.Sleep(time.Millisecond * 50)
+		assert.Equal(t, test.tokenIds, receiveTransferTokenIds(t, events, len(test.tokenIds)))
+
+		cancel()
+		dropped := 0
+		for err := range errs {
+			var droppedErr *DroppedEventError
+			if errors.As(err, &droppedErr) {
+				dropped += 1
+			}
+		}
+		assert.Equal(t, 5-len(test.tokenIds), dropped)
+	}
+}
+
+func TestEventStreamUnsubscribeNeverBlocks(t *testing.T) {
+	service := &mockLogsService{blockNumber: 10}
+	server := newMockHttpLogsServer(t, service)
+	defer server.Close()
+
+	// The listener stops right away with an error that nobody reads
+	subscription := newTestEventListenerEvents(t, server.URL).AddEventListener(context.Background(), "Unknown", func(event ContractEvent) {})
+	time.Sleep(time.Millisecond * 20)
+
+	subscription.Unsubscribe()
+	subscription.Unsubscribe()
+	assert.NotNil(t, <-subscription.Err())
+}
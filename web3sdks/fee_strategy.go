@@ -0,0 +1,275 @@
+package web3sdks
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	defaultBaseFeeMultiplier    = 2
+	defaultFeeHistoryBlockCount = 10
+	defaultFeeHistoryPercentile = 50
+)
+
+// The priority fee used by the default fee strategy, 2.5 gwei
+var defaultGasTipCap = big.NewInt(2500000000)
+
+// The fees to use for a transaction. Dynamic fee (EIP-1559) transactions use the tip and fee
+// caps, while legacy and access list transactions use the gas price.
+type GasFees struct {
+	// The max priority fee per gas paid to the block producer
+	GasTipCap *big.Int
+	// The max total fee per gas, including the base fee
+	GasFeeCap *big.Int
+	// The gas price of a legacy or access list transaction
+	GasPrice *big.Int
+}
+
+// A FeeStrategy decides the fees paid by every transaction sent by the SDK. It can be set for
+// an SDK with the FeeStrategy field of the SDKOptions, and defaults to a FixedFeeStrategy
+// with a 2.5 gwei priority fee.
+//
+// Example
+//
+//	sdk, err := web3sdks.NewWeb3sdksSDK("polygon", &web3sdks.SDKOptions{
+//		PrivateKey: "...",
+//		FeeStrategy: &web3sdks.MaxFeeCeilingStrategy{
+//			Strategy:     &web3sdks.FeeHistoryStrategy{Percentile: 75},
+//			MaxFeePerGas: big.NewInt(500000000000),
+//		},
+//	})
+type FeeStrategy interface {
+	// Get the fees for a transaction given the base fee of the latest block. The base fee is nil
+	// for legacy and access list transactions, in which case only the gas price should be set.
+	GetFees(ctx context.Context, handler *ProviderHandler, baseFee *big.Int) (*GasFees, error)
+	// Get the maximum fee per gas the strategy never exceeds, or nil if it has no ceiling. The
+	// ceiling is also enforced when a pending transaction is sped up or cancelled, so a strategy
+	// wrapping another one must return the ceiling of the wrapped strategy.
+	MaxFeeCap() *big.Int
+}
+
+// Fee strategy with a fixed priority fee. The max fee is either fixed as well, or computed as
+// BaseFeeMultiplier times the base fee plus the priority fee.
+type FixedFeeStrategy struct {
+	// The priority fee, defaults to 2.5 gwei
+	GasTipCap *big.Int
+	// The max fee, computed from the base fee if nil
+	GasFeeCap *big.Int
+	// Multiplier applied to the base fee when computing the max fee, defaults to 2
+	BaseFeeMultiplier int64
+	// The gas price of legacy and access list transactions, estimated with eth_gasPrice if nil
+	GasPrice *big.Int
+}
+
+func (strategy *FixedFeeStrategy) GetFees(ctx context.Context, handler *ProviderHandler, baseFee *big.Int) (*GasFees, error) {
+	if baseFee == nil {
+		if strategy.GasPrice != nil {
+			return &GasFees{GasPrice: big.NewInt(0).Set(strategy.GasPrice)}, nil
+		}
+
+		return getLegacyGasFees(ctx, handler)
+	}
+
+	tipCap := defaultGasTipCap
+	if strategy.GasTipCap != nil {
+		tipCap = strategy.GasTipCap
+	}
+
+	feeCap := strategy.GasFeeCap
+	if feeCap == nil {
+		feeCap = getFeeCap(baseFee, tipCap, strategy.BaseFeeMultiplier)
+	}
+
+	return &GasFees{
+		GasTipCap: big.NewInt(0).Set(tipCap),
+		GasFeeCap: big.NewInt(0).Set(feeCap),
+	}, nil
+}
+
+func (strategy *FixedFeeStrategy) MaxFeeCap() *big.Int {
+	return nil
+}
+
+// Fee strategy that uses the priority fee suggested by the node through eth_maxPriorityFeePerGas.
+type MaxPriorityFeeStrategy struct {
+	// Multiplier applied to the base fee when computing the max fee, defaults to 2
+	BaseFeeMultiplier int64
+}
+
+func (strategy *MaxPriorityFeeStrategy) GetFees(ctx context.Context, handler *ProviderHandler, baseFee *big.Int) (*GasFees, error) {
+	if baseFee == nil {
+		return getLegacyGasFees(ctx, handler)
+	}
+
+	tipCap, err := handler.GetProvider().SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GasFees{
+		GasTipCap: tipCap,
+		GasFeeCap: getFeeCap(baseFee, tipCap, strategy.BaseFeeMultiplier),
+	}, nil
+}
+
+func (strategy *MaxPriorityFeeStrategy) MaxFeeCap() *big.Int {
+	return nil
+}
+
+// Fee strategy that uses a percentile of the priority fees paid in recent blocks, obtained
+// through eth_feeHistory. Requires the SDK to be created from an RPC URL or RPC client.
+type FeeHistoryStrategy struct {
+	// Number of recent blocks to sample, defaults to 10
+	BlockCount uint64
+	// Percentile of the priority fees paid in each block, between 0 and 100, defaults to 50
+	Percentile float64
+	// Multiplier applied to the base fee when computing the max fee, defaults to 2
+	BaseFeeMultiplier int64
+}
+
+type feeHistoryResult struct {
+	OldestBlock  *hexutil.Big     `json:"oldestBlock"`
+	Reward       [][]*hexutil.Big `json:"reward"`
+	BaseFee      []*hexutil.Big   `json:"baseFeePerGas"`
+	GasUsedRatio []float64        `json:"gasUsedRatio"`
+}
+
+func (strategy *FeeHistoryStrategy) GetFees(ctx context.Context, handler *ProviderHandler, baseFee *big.Int) (*GasFees, error) {
+	if baseFee == nil {
+		return getLegacyGasFees(ctx, handler)
+	}
+
+	blockCount := strategy.BlockCount
+	if blockCount == 0 {
+		blockCount = defaultFeeHistoryBlockCount
+	}
+	percentile := strategy.Percentile
+	if percentile == 0 {
+		percentile = defaultFeeHistoryPercentile
+	}
+
+	rpcClient := handler.GetRpcClient()
+	if rpcClient == nil {
+		return nil, errors.New("FeeHistoryStrategy requires the SDK to be created from an RPC URL or RPC client")
+	}
+
+	history := &feeHistoryResult{}
+	if err := rpcClient.CallContext(ctx, history, "eth_feeHistory", hexutil.Uint64(blockCount), "latest", []float64{percentile}); err != nil {
+		return nil, err
+	}
+
+	tipCap := big.NewInt(0)
+	samples := int64(0)
+	for _, rewards := range history.Reward {
+		if len(rewards) > 0 && rewards[0] != nil {
+			tipCap.Add(tipCap, rewards[0].ToInt())
+			samples += 1
+		}
+	}
+	if samples == 0 {
+		return nil, errors.New("eth_feeHistory returned no priority fee samples")
+	}
+	tipCap.Div(tipCap, big.NewInt(samples))
+
+	// The last base fee returned is the one of the next block, which is more accurate than the latest one
+	if len(history.BaseFee) > 0 && history.BaseFee[len(history.BaseFee)-1] != nil {
+		baseFee = history.BaseFee[len(history.BaseFee)-1].ToInt()
+	}
+
+	return &GasFees{
+		GasTipCap: tipCap,
+		GasFeeCap: getFeeCap(baseFee, tipCap, strategy.BaseFeeMultiplier),
+	}, nil
+}
+
+func (strategy *FeeHistoryStrategy) MaxFeeCap() *big.Int {
+	return nil
+}
+
+// Wraps another fee strategy and enforces a ceiling on the max fee per gas. The max fee of the
+// wrapped strategy is lowered to the ceiling when possible, and the transaction is aborted with a
+// *MaxFeeExceededError when the base fee plus the priority fee alone exceed it. For legacy and
+// access list transactions, the transaction is aborted when the gas price exceeds the ceiling.
+type MaxFeeCeilingStrategy struct {
+	// The strategy used to compute the fees, defaults to the default fee strategy
+	Strategy FeeStrategy
+	// The maximum fee per gas that will ever be paid
+	MaxFeePerGas *big.Int
+}
+
+func (strategy *MaxFeeCeilingStrategy) GetFees(ctx context.Context, handler *ProviderHandler, baseFee *big.Int) (*GasFees, error) {
+	var inner FeeStrategy = &FixedFeeStrategy{}
+	if strategy.Strategy != nil {
+		inner = strategy.Strategy
+	}
+
+	fees, err := inner.GetFees(ctx, handler, baseFee)
+	if err != nil || strategy.MaxFeePerGas == nil {
+		return fees, err
+	}
+
+	if baseFee == nil {
+		if fees.GasPrice == nil {
+			return nil, errors.New("The fee strategy wrapped by the MaxFeeCeilingStrategy returned no gas price")
+		}
+		if fees.GasPrice.Cmp(strategy.MaxFeePerGas) > 0 {
+			return nil, &MaxFeeExceededError{
+				RequiredFeePerGas: fees.GasPrice,
+				MaxFeePerGas:      strategy.MaxFeePerGas,
+			}
+		}
+
+		return fees, nil
+	}
+
+	if fees.GasTipCap == nil || fees.GasFeeCap == nil {
+		return nil, errors.New("The fee strategy wrapped by the MaxFeeCeilingStrategy returned no priority fee or max fee")
+	}
+
+	required := big.NewInt(0).Add(baseFee, fees.GasTipCap)
+	if required.Cmp(strategy.MaxFeePerGas) > 0 {
+		return nil, &MaxFeeExceededError{
+			RequiredFeePerGas: required,
+			MaxFeePerGas:      strategy.MaxFeePerGas,
+		}
+	}
+
+	if fees.GasFeeCap.Cmp(strategy.MaxFeePerGas) > 0 {
+		fees.GasFeeCap = big.NewInt(0).Set(strategy.MaxFeePerGas)
+	}
+
+	return fees, nil
+}
+
+func (strategy *MaxFeeCeilingStrategy) MaxFeeCap() *big.Int {
+	maxFeeCap := strategy.MaxFeePerGas
+	if strategy.Strategy != nil {
+		if innerCap := strategy.Strategy.MaxFeeCap(); innerCap != nil && (maxFeeCap == nil || innerCap.Cmp(maxFeeCap) < 0) {
+			maxFeeCap = innerCap
+		}
+	}
+
+	return maxFeeCap
+}
+
+// Gas price estimated by the node through eth_gasPrice
+func getLegacyGasFees(ctx context.Context, handler *ProviderHandler) (*GasFees, error) {
+	gasPrice, err := handler.GetProvider().SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GasFees{GasPrice: gasPrice}, nil
+}
+
+func getFeeCap(baseFee *big.Int, tipCap *big.Int, multiplier int64) *big.Int {
+	if multiplier <= 0 {
+		multiplier = defaultBaseFeeMultiplier
+	}
+
+	feeCap := big.NewInt(0).Mul(baseFee, big.NewInt(multiplier))
+	return feeCap.Add(feeCap, tipCap)
+}
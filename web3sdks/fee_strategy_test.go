@@ -0,0 +1,205 @@
+package web3sdks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func newFeeMockRpcServer(baseFee int64) *mockRpcServer {
+	server := newMockRpcServer()
+	server.handle("eth_chainId", func(params []json.RawMessage) (interface{}, error) {
+		return hexutil.Uint64(1), nil
+	})
+	server.handle("eth_getBlockByNumber", func(params []json.RawMessage) (interface{}, error) {
+		return mockHeader(10, baseFee), nil
+	})
+
+	return server
+}
+
+func TestDefaultFeeStrategy(t *testing.T) {
+	server := newFeeMockRpcServer(100)
+	defer server.Close()
+
+	txOpts, err := server.helper(t).GetTxOptions(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(2500000000), txOpts.GasTipCap)
+	assert.Equal(t, big.NewInt(2500000200), txOpts.GasFeeCap)
+}
+
+func TestFixedFeeStrategy(t *testing.T) {
+	server := newFeeMockRpcServer(100)
+	defer server.Close()
+
+	helper := server.helper(t)
+	helper.SetFeeStrategy(&FixedFeeStrategy{
+		GasTipCap:         big.NewInt(5),
+		BaseFeeMultiplier: 3,
+	})
+
+	txOpts, err := helper.GetTxOptions(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(5), txOpts.GasTipCap)
+	assert.Equal(t, big.NewInt(305), txOpts.GasFeeCap)
+}
+
+func TestMaxPriorityFeeStrategy(t *testing.T) {
+	server := newFeeMockRpcServer(100)
+	defer server.Close()
+	server.handle("eth_maxPriorityFeePerGas", func(params []json.RawMessage) (interface{}, error) {
+		return hexutil.Uint64(7), nil
+	})
+
+	helper := server.helper(t)
+	helper.SetFeeStrategy(&MaxPriorityFeeStrategy{})
+
+	txOpts, err := helper.GetTxOptions(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(7), txOpts.GasTipCap)
+	assert.Equal(t, big.NewInt(207), txOpts.GasFeeCap)
+}
+
+func TestFeeHistoryStrategy(t *testing.T) {
+	server := newFeeMockRpcServer(100)
+	defer server.Close()
+	server.handle("eth_feeHistory", func(params []json.RawMessage) (interface{}, error) {
+		var percentiles []float64
+		json.Unmarshal(params[2], &percentiles)
+		assert.Equal(t, []float64{75}, percentiles)
+
+		return map[string]interface{}{
+			"oldestBlock":   "0x8",
+			"reward":        [][]string{{"0xa"}, {"0x14"}, {"0x1e"}},
+			"baseFeePerGas": []string{"0x64", "0x64", "0x64", "0x78"},
+			"gasUsedRatio":  []float64{0.5, 0.5, 0.5},
+		}, nil
+	})
+
+	helper := server.helper(t)
+	helper.SetFeeStrategy(&FeeHistoryStrategy{BlockCount: 3, Percentile: 75})
+
+	txOpts, err := helper.GetTxOptions(context.Background())
+	assert.Nil(t, err)
+	// Average of the rewards, and the base fee of the next block
+	assert.Equal(t, big.NewInt(20), txOpts.GasTipCap)
+	assert.Equal(t, big.NewInt(260), txOpts.GasFeeCap)
+}
+
+func TestMaxFeeCeilingStrategy(t *testing.T) {
+	server := newFeeMockRpcServer(100)
+	defer server.Close()
+
+	helper := server.helper(t)
+	helper.SetFeeStrategy(&MaxFeeCeilingStrategy{
+		Strategy:     &FixedFeeStrategy{GasTipCap: big.NewInt(10)},
+		MaxFeePerGas: big.NewInt(150),
+	})
+
+	// The max fee of 210 is lowered to the ceiling since 110 is enough to be included
+	txOpts, err := helper.GetTxOptions(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(150), txOpts.GasFeeCap)
+
+	helper.SetFeeStrategy(&MaxFeeCeilingStrategy{
+		Strategy:     &FixedFeeStrategy{GasTipCap: big.NewInt(10)},
+		MaxFeePerGas: big.NewInt(100),
+	})
+
+	_, err = helper.GetTxOptions(context.Background())
+	maxFeeErr := &MaxFeeExceededError{}
+	assert.True(t, errors.As(err, &maxFeeErr))
+	assert.Equal(t, big.NewInt(110), maxFeeErr.RequiredFeePerGas)
+}
+
+// Fee strategy returning the same fees whether or not there is a base fee
+type staticFeeStrategy struct {
+	fees GasFees
+}
+
+func (strategy *staticFeeStrategy) GetFees(ctx context.Context, handler *ProviderHandler, baseFee *big.Int) (*GasFees, error) {
+	fees := strategy.fees
+	return &fees, nil
+}
+
+func (strategy *staticFeeStrategy) MaxFeeCap() *big.Int {
+	return nil
+}
+
+func TestMaxFeeCeilingStrategyRejectsMissingFees(t *testing.T) {
+	legacyOnly := &MaxFeeCeilingStrategy{
+		Strategy:     &staticFeeStrategy{GasFees{GasPrice: big.NewInt(50)}},
+		MaxFeePerGas: big.NewInt(150),
+	}
+	_, err := legacyOnly.GetFees(context.Background(), nil, big.NewInt(100))
+	assert.NotNil(t, err)
+	fees, err := legacyOnly.GetFees(context.Background(), nil, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(50), fees.GasPrice)
+
+	dynamicOnly := &MaxFeeCeilingStrategy{
+		Strategy:     &staticFeeStrategy{GasFees{GasTipCap: big.NewInt(10), GasFeeCap: big.NewInt(200)}},
+		MaxFeePerGas: big.NewInt(150),
+	}
+	_, err = dynamicOnly.GetFees(context.Background(), nil, nil)
+	assert.NotNil(t, err)
+	fees, err = dynamicOnly.GetFees(context.Background(), nil, big.NewInt(100))
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(150), fees.GasFeeCap)
+}
+
+func TestFeeStrategyAppliesToErc20Approval(t *testing.T) {
+	server := newFeeMockRpcServer(100)
+	defer server.Close()
+
+	var sentTx *types.Transaction
+	server.handle("eth_call", func(params []json.RawMessage) (interface{}, error) {
+		// No allowance yet
+		return hexutil.Encode(common.LeftPadBytes(nil, 32)), nil
+	})
+	server.handle("eth_getCode", func(params []json.RawMessage) (interface{}, error) {
+		return "0x01", nil
+	})
+	server.handle("eth_getTransactionCount", func(params []json.RawMessage) (interface{}, error) {
+		return hexutil.Uint64(0), nil
+	})
+	server.handle("eth_estimateGas", func(params []json.RawMessage) (interface{}, error) {
+		return hexutil.Uint64(50000), nil
+	})
+	server.handle("eth_sendRawTransaction", func(params []json.RawMessage) (interface{}, error) {
+		var rawTx hexutil.Bytes
+		json.Unmarshal(params[0], &rawTx)
+		sentTx = new(types.Transaction)
+		sentTx.UnmarshalBinary(rawTx)
+		return sentTx.Hash(), nil
+	})
+	server.handle("eth_getTransactionByHash", func(params []json.RawMessage) (interface{}, error) {
+		return mockMinedTxJson(sentTx, 10), nil
+	})
+	server.handle("eth_getTransactionReceipt", func(params []json.RawMessage) (interface{}, error) {
+		return mockReceipt(sentTx, 10, types.ReceiptStatusSuccessful), nil
+	})
+
+	helper := server.helper(t)
+	helper.SetFeeStrategy(&FixedFeeStrategy{GasTipCap: big.NewInt(3), GasFeeCap: big.NewInt(400)})
+
+	txOpts, err := helper.GetTxOptions(context.Background())
+	assert.Nil(t, err)
+
+	currency := "0x0000000000000000000000000000000000000001"
+	err = setErc20Allowance(context.Background(), helper, big.NewInt(1000), currency, txOpts)
+	assert.Nil(t, err)
+	if !assert.NotNil(t, sentTx) {
+		return
+	}
+	assert.Equal(t, common.HexToAddress(currency), *sentTx.To())
+	assert.Equal(t, big.NewInt(3), sentTx.GasTipCap())
+	assert.Equal(t, big.NewInt(400), sentTx.GasFeeCap())
+}
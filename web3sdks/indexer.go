@@ -0,0 +1,461 @@
+package web3sdks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Number of blocks whose events are saved at once, so that the progress of an indexer catching up
+// with a long history is saved regularly
+const indexerBatchSize = 10000
+
+// Options of an Indexer.
+type IndexerOptions struct {
+	// Block to start indexing from, for the contracts that weren't indexed yet. Defaults to 0
+	FromBlock uint64
+	// Number of blocks that must be mined on top of a block before its events are indexed, to
+	// avoid indexing events that are removed by a reorg. Defaults to 0, indexing the events as
+	// soon as they are mined
+	Confirmations uint64
+	// Maximum number of blocks queried in a single request, like for GetEvents. Defaults to 0,
+	// querying the blocks of a batch at once
+	MaxChunkSize uint64
+	// How often a started indexer checks for new events. Defaults to 2 seconds
+	PollInterval time.Duration
+	// Backend the events are read from. Defaults to the provider of each followed contract
+	Backend IndexerBackend
+}
+
+// The chain an Indexer reads the events from. It's implemented by the ethclient.Client providers of
+// the SDK, and by the simulated backend of go-ethereum to test an indexer without a node.
+type IndexerBackend interface {
+	bind.ContractFilterer
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// The events to get from an indexer.
+type IndexerQuery struct {
+	// Address of the contract that emitted the events, all the followed contracts by default
+	Contract string
+	// Name of the events, all the events by default
+	EventName string
+	// Filters on the indexed arguments of the event, like for GetEvents, which require an event name
+	Filters map[string]interface{}
+	// First block of the events
+	FromBlock uint64
+	// Last block of the events, no limit by default
+	ToBlock *uint64
+	// Hash of the transaction that emitted the events, any transaction by default
+	TransactionHash string
+}
+
+// An indexer follows the events of one or more contracts and saves them in a store, so that they
+// can be queried without scanning the chain again.
+//
+// Example
+//
+//	store, err := web3sdks.NewFileIndexerStore("./indexer")
+//	defer store.Close()
+//
+//	indexer := web3sdks.NewIndexer(store, &web3sdks.IndexerOptions{
+//	  FromBlock:     30000000,
+//	  Confirmations: 10,
+//	})
+//
+//	// Follow the Transfer events of an NFT collection and all the events of a marketplace
+//	err = indexer.Follow(nftCollection.Events.ContractEvents, "Transfer")
+//	err = indexer.Follow(marketplace.Events.ContractEvents)
+//
+//	// Index the new events in the background
+//	subscription := indexer.Start(context.Background())
+//	defer subscription.Unsubscribe()
+//
+//	// And query the indexed events
+//	events, err := indexer.GetEvents(context.Background(), web3sdks.IndexerQuery{
+//	  Contract:  "{{contract_address}}",
+//	  EventName: "Transfer",
+//	})
+type Indexer struct {
+	store   IndexerStore
+	options IndexerOptions
+
+	mu        sync.Mutex
+	contracts []*indexedContract
+	// Only one sync runs at a time
+	syncMu sync.Mutex
+}
+
+type indexedContract struct {
+	events     *ContractEvents
+	eventNames []string
+}
+
+// Create an indexer saving the events of the contracts it follows in a store.
+//
+// store: the store of the indexed events, like NewMemoryIndexerStore or NewFileIndexerStore
+//
+// options: optional options of the indexer, like the block to start indexing from
+//
+// returns: the indexer, which doesn't follow any contract yet
+func NewIndexer(store IndexerStore, options ...*IndexerOptions) *Indexer {
+	indexer := &Indexer{store: store}
+	if len(options) > 0 && options[0] != nil {
+		indexer.options = *options[0]
+	}
+	if indexer.options.PollInterval <= 0 {
+		indexer.options.PollInterval = defaultEventPollInterval
+	}
+
+	return indexer
+}
+
+// Follow the events of a contract, which are indexed on the next sync.
+//
+// A contract can only be followed once, and always with the same events in a store: the progress
+// of a contract covers the events it was first indexed with, so the other events of the blocks
+// already indexed would be missing. Syncing a contract indexed with other events returns an error.
+//
+// events: the events of the contract, like contract.Events of any contract
+//
+// eventNames: the names of the events to index, all the events of the contract by default
+//
+// returns: an error if the contract is already followed
+//
+// Example
+//
+//	err := indexer.Follow(contract.Events, "Transfer", "TokensMinted")
+func (indexer *Indexer) Follow(events *ContractEvents, eventNames ...string) error {
+	indexer.mu.Lock()
+	defer indexer.mu.Unlock()
+
+	address := events.helper.getAddress()
+	for _, contract := range indexer.contracts {
+		if contract.events.helper.getAddress() == address {
+			return fmt.Errorf("Contract '%s' is already followed by the indexer", address.String())
+		}
+	}
+
+	indexer.contracts = append(indexer.contracts, &indexedContract{events: events, eventNames: normalizeIndexerEventNames(eventNames)})
+	return nil
+}
+
+// Index the events of all the followed contracts up to the latest block, minus the confirmations.
+//
+// returns: an error if a contract couldn't be indexed. The logs that can't be decoded with the
+// events of a contract ABI are indexed as they are, and are returned as an *UndecodedLogsError
+// once all the events are indexed
+//
+// Example
+//
+//	err := indexer.Sync(context.Background())
+func (indexer *Indexer) Sync(ctx context.Context) error {
+	indexer.syncMu.Lock()
+	defer indexer.syncMu.Unlock()
+
+	undecodedErr := &UndecodedLogsError{}
+	for _, contract := range indexer.getContracts() {
+		err := indexer.syncContract(ctx, contract)
+
+		var contractUndecodedErr *UndecodedLogsError
+		if errors.As(err, &contractUndecodedErr) {
+			undecodedErr.LogErrors = append(undecodedErr.LogErrors, contractUndecodedErr.LogErrors...)
+		} else if err != nil {
+			return err
+		}
+	}
+
+	if len(undecodedErr.LogErrors) > 0 {
+		return undecodedErr
+	}
+
+	return nil
+}
+
+// Index the new events in the background every poll interval, until the context is done or the
+// indexer is stopped.
+//
+// returns: An EventSubscription object that can be used to stop the indexer or check for errors
+//
+// Example
+//
+//	subscription := indexer.Start(context.Background())
+//
+//	go func() {
+//	  for err := range subscription.Err() {
+//	    fmt.Println(err)
+//	  }
+//	}()
+//
+//	subscription.Unsubscribe()
+func (indexer *Indexer) Start(ctx context.Context) EventSubscription {
+	done := make(chan bool)
+	errors := make(chan error, eventErrorBufferSize)
+	once := sync.Once{}
+
+	go func() {
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			case <-timer.C:
+			}
+
+			if err := indexer.Sync(ctx); err != nil {
+				sendEventError(errors, err)
+			}
+			timer.Reset(indexer.options.PollInterval)
+		}
+	}()
+
+	return EventSubscription{
+		Err: func() <-chan error {
+			return errors
+		},
+		Unsubscribe: func() {
+			once.Do(func() {
+				close(done)
+			})
+		},
+	}
+}
+
+// Get the indexing progress of a followed contract.
+//
+// contract: the address of the contract
+//
+// returns: the progress of the contract, or nil if the contract wasn't indexed yet
+func (indexer *Indexer) GetProgress(ctx context.Context, contract string) (*IndexerProgress, error) {
+	return indexer.store.GetProgress(ctx, common.HexToAddress(contract))
+}
+
+// Get the indexed events matching a query, without querying the chain.
+//
+// query: the contract, event name, indexed arguments, block range and transaction of the events
+//
+// returns: the events matching the query, ordered by block number and log index. If some of the
+// indexed logs can't be decoded with the events of the contract ABI, the decoded events are
+// returned along with an *UndecodedLogsError
+//
+// Example
+//
+//	// Get the transfers of the token 42 in the blocks indexed so far
+//	events, err := indexer.GetEvents(context.Background(), web3sdks.IndexerQuery{
+//	  Contract:  "{{contract_address}}",
+//	  EventName: "Transfer",
+//	  Filters: map[string]interface{}{
+//	    "tokenId": big.NewInt(42),
+//	  },
+//	})
+func (indexer *Indexer) GetEvents(ctx context.Context, query IndexerQuery) ([]ContractEvent, error) {
+	if query.Filters != nil && query.EventName == "" {
+		return nil, errors.New("Filters require an event name")
+	}
+
+	var txHash *common.Hash
+	if query.TransactionHash != "" {
+		hash := common.HexToHash(query.TransactionHash)
+		txHash = &hash
+	}
+
+	found := false
+	events := []ContractEvent{}
+	undecodedErr := &UndecodedLogsError{}
+	for _, contract := range indexer.getContracts() {
+		address := contract.events.helper.getAddress()
+		if query.Contract != "" && address != common.HexToAddress(query.Contract) {
+			continue
+		}
+		found = true
+
+		filter := IndexerLogFilter{
+			Contract:        address,
+			FromBlock:       query.FromBlock,
+			ToBlock:         query.ToBlock,
+			TransactionHash: txHash,
+		}
+		if query.EventName != "" {
+			if _, ok := contract.events.abi.Events[query.EventName]; !ok && query.Contract == "" {
+				// The event is only queried from the followed contracts that have it
+				continue
+			}
+
+			eventQuery, err := contract.events.getEventQuery(query.EventName, query.Filters)
+			if err != nil {
+				return nil, err
+			}
+			filter.Topics = eventQuery.Topics
+		}
+
+		logs, err := indexer.store.QueryLogs(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		decoded, err := contract.events.decodeLogs(logs)
+
+		var contractUndecodedErr *UndecodedLogsError
+		if errors.As(err, &contractUndecodedErr) {
+			undecodedErr.LogErrors = append(undecodedErr.LogErrors, contractUndecodedErr.LogErrors...)
+		} else if err != nil {
+			return nil, err
+		}
+		events = append(events, decoded...)
+	}
+
+	if !found && query.Contract != "" {
+		return nil, fmt.Errorf("Contract '%s' isn't followed by the indexer", query.Contract)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		a, b := events[i].Transaction, events[j].Transaction
+		return a.BlockNumber < b.BlockNumber || (a.BlockNumber == b.BlockNumber && a.Index < b.Index)
+	})
+
+	if len(undecodedErr.LogErrors) > 0 {
+		return events, undecodedErr
+	}
+
+	return events, nil
+}
+
+func (indexer *Indexer) getContracts() []*indexedContract {
+	indexer.mu.Lock()
+	defer indexer.mu.Unlock()
+
+	return append([]*indexedContract{}, indexer.contracts...)
+}
+
+// Index the events of a contract from its progress to the latest confirmed block, saving the
+// events and the progress of every batch of blocks
+func (indexer *Indexer) syncContract(ctx context.Context, contract *indexedContract) error {
+	address := contract.events.helper.getAddress()
+	progress, err := indexer.store.GetProgress(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	fromBlock := indexer.options.FromBlock
+	if progress != nil {
+		if !equalIndexerEventNames(progress.EventNames, contract.eventNames) {
+			return fmt.Errorf(
+				"Contract '%s' was indexed with the events %v instead of %v, index it with the same events or in another store",
+				address.String(), formatIndexerEventNames(progress.EventNames), formatIndexerEventNames(contract.eventNames),
+			)
+		}
+		fromBlock = progress.NextBlock
+	}
+
+	var backend IndexerBackend = contract.events.helper.GetProvider()
+	if indexer.options.Backend != nil {
+		backend = indexer.options.Backend
+	}
+
+	latestHeader, err := backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+	latestBlock := latestHeader.Number.Uint64()
+	if latestBlock < indexer.options.Confirmations {
+		return nil
+	}
+	confirmedBlock := latestBlock - indexer.options.Confirmations
+
+	query, err := contract.events.getEventsQuery(contract.eventNames)
+	if err != nil {
+		return err
+	}
+
+	undecodedErr := &UndecodedLogsError{}
+	for fromBlock <= confirmedBlock {
+		toBlock := confirmedBlock
+		if toBlock-fromBlock+1 > indexerBatchSize {
+			toBlock = fromBlock + indexerBatchSize - 1
+		}
+
+		batchLogs, err := filterLogsInChunks(ctx, backend, query, EventQueryOptions{
+			FromBlock:    fromBlock,
+			ToBlock:      &toBlock,
+			MaxChunkSize: indexer.options.MaxChunkSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		// The logs that can't be decoded are saved as well, so that they can be decoded later with
+		// the right ABI instead of being skipped by the progress
+		_, err = contract.events.decodeLogs(batchLogs)
+
+		var batchUndecodedErr *UndecodedLogsError
+		if errors.As(err, &batchUndecodedErr) {
+			undecodedErr.LogErrors = append(undecodedErr.LogErrors, batchUndecodedErr.LogErrors...)
+		} else if err != nil {
+			return err
+		}
+
+		if err := indexer.store.SaveLogs(ctx, address, batchLogs, IndexerProgress{NextBlock: toBlock + 1, EventNames: contract.eventNames}); err != nil {
+			return err
+		}
+		fromBlock = toBlock + 1
+	}
+
+	if len(undecodedErr.LogErrors) > 0 {
+		return undecodedErr
+	}
+
+	return nil
+}
+
+// Sort the names of the events followed, nil standing for all the events of a contract
+func normalizeIndexerEventNames(eventNames []string) []string {
+	if len(eventNames) == 0 {
+		return nil
+	}
+
+	names := append([]string{}, eventNames...)
+	sort.Strings(names)
+	unique := names[:1]
+	for _, name := range names[1:] {
+		if name != unique[len(unique)-1] {
+			unique = append(unique, name)
+		}
+	}
+
+	return unique
+}
+
+func equalIndexerEventNames(a []string, b []string) bool {
+	a, b = normalizeIndexerEventNames(a), normalizeIndexerEventNames(b)
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func formatIndexerEventNames(eventNames []string) string {
+	if len(eventNames) == 0 {
+		return "all"
+	}
+
+	return strings.Join(eventNames, ", ")
+}
@@ -0,0 +1,255 @@
+package web3sdks
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/leveldb"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+)
+
+const (
+	// Cache size in megabytes and number of open files of the file-backed indexer store
+	fileIndexerStoreCache   = 16
+	fileIndexerStoreHandles = 16
+)
+
+// Key prefixes of the key-value indexer store
+var (
+	indexerProgressPrefix = []byte("p")
+	indexerLogPrefix      = []byte("l")
+	indexerTxPrefix       = []byte("t")
+)
+
+// Progress of the indexing of a contract.
+type IndexerProgress struct {
+	// Next block to index, all the events of the blocks before it are indexed
+	NextBlock uint64
+	// Sorted names of the indexed events, nil when all the events of the contract are indexed
+	EventNames []string
+}
+
+// The logs to get from an indexer store.
+type IndexerLogFilter struct {
+	// Address of the contract that emitted the logs
+	Contract common.Address
+	// Topics of the logs, with the same semantics as the topics of a log query: the logs must
+	// match one of the topics at each position, and an empty position matches any topic
+	Topics [][]common.Hash
+	// First block of the logs
+	FromBlock uint64
+	// Last block of the logs, no limit by default
+	ToBlock *uint64
+	// Hash of the transaction that emitted the logs, any transaction by default
+	TransactionHash *common.Hash
+}
+
+// Storage of the logs indexed by an Indexer, and of the indexing progress of every contract.
+//
+// The SDK provides an in-memory store and a file-backed embedded store, and any other database
+// can be used by implementing this interface.
+type IndexerStore interface {
+	// Save the logs indexed from a contract along with the new progress of the contract, so that
+	// the logs are never saved without the progress or the other way around
+	SaveLogs(ctx context.Context, contract common.Address, logs []types.Log, progress IndexerProgress) error
+	// Get the progress of a contract, or nil if the contract wasn't indexed yet
+	GetProgress(ctx context.Context, contract common.Address) (*IndexerProgress, error)
+	// Get the logs matching the filter, ordered by block number and log index
+	QueryLogs(ctx context.Context, filter IndexerLogFilter) ([]types.Log, error)
+	// Close the store, releasing its resources
+	Close() error
+}
+
+// Indexer store backed by a key-value database. The logs are keyed by contract, block number and
+// log index so that block ranges are read in order, and referenced by transaction hash.
+type kvIndexerStore struct {
+	db ethdb.KeyValueStore
+}
+
+// Create an indexer store keeping the logs in memory, which are lost once the process stops.
+//
+// returns: the in-memory indexer store
+//
+// Example
+//
+//	indexer := web3sdks.NewIndexer(web3sdks.NewMemoryIndexerStore())
+func NewMemoryIndexerStore() IndexerStore {
+	return &kvIndexerStore{db: memorydb.New()}
+}
+
+// Create an indexer store keeping the logs in an embedded database in a directory, so that a
+// restarted indexer resumes where it stopped.
+//
+// path: the directory of the database, which is created if it doesn't exist
+//
+// returns: the file-backed indexer store, which must be closed once it isn't used anymore
+//
+// Example
+//
+//	store, err := web3sdks.NewFileIndexerStore("./indexer")
+//	defer store.Close()
+//
+//	indexer := web3sdks.NewIndexer(store)
+func NewFileIndexerStore(path string) (IndexerStore, error) {
+	db, err := leveldb.New(path, fileIndexerStoreCache, fileIndexerStoreHandles, "", false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &kvIndexerStore{db: db}, nil
+}
+
+func (store *kvIndexerStore) SaveLogs(ctx context.Context, contract common.Address, logs []types.Log, progress IndexerProgress) error {
+	batch := store.db.NewBatch()
+	for _, log := range logs {
+		value, err := json.Marshal(&log)
+		if err != nil {
+			return err
+		}
+
+		logKey := getIndexerLogKey(contract, log.BlockNumber, log.Index)
+		if err := batch.Put(logKey, value); err != nil {
+			return err
+		}
+		if err := batch.Put(concatBytes(indexerTxPrefix, log.TxHash.Bytes(), logKey), []byte{}); err != nil {
+			return err
+		}
+	}
+
+	value, err := json.Marshal(progress)
+	if err != nil {
+		return err
+	}
+	if err := batch.Put(concatBytes(indexerProgressPrefix, contract.Bytes()), value); err != nil {
+		return err
+	}
+
+	return batch.Write()
+}
+
+func (store *kvIndexerStore) GetProgress(ctx context.Context, contract common.Address) (*IndexerProgress, error) {
+	key := concatBytes(indexerProgressPrefix, contract.Bytes())
+	if found, err := store.db.Has(key); err != nil || !found {
+		return nil, err
+	}
+
+	value, err := store.db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := &IndexerProgress{}
+	if err := json.Unmarshal(value, progress); err != nil {
+		return nil, err
+	}
+
+	return progress, nil
+}
+
+func (store *kvIndexerStore) QueryLogs(ctx context.Context, filter IndexerLogFilter) ([]types.Log, error) {
+	if filter.TransactionHash != nil {
+		return store.queryTransactionLogs(filter)
+	}
+
+	start := make([]byte, 8)
+	binary.BigEndian.PutUint64(start, filter.FromBlock)
+	iterator := store.db.NewIterator(concatBytes(indexerLogPrefix, filter.Contract.Bytes()), start)
+	defer iterator.Release()
+
+	logs := []types.Log{}
+	for iterator.Next() {
+		log := types.Log{}
+		if err := json.Unmarshal(iterator.Value(), &log); err != nil {
+			return nil, err
+		}
+		if filter.ToBlock != nil && log.BlockNumber > *filter.ToBlock {
+			break
+		}
+
+		if matchesIndexerLogFilter(log, filter) {
+			logs = append(logs, log)
+		}
+	}
+
+	return logs, iterator.Error()
+}
+
+// Get the logs of a transaction, which are referenced by the keys of the logs in the order of the
+// contract, block number and log index
+func (store *kvIndexerStore) queryTransactionLogs(filter IndexerLogFilter) ([]types.Log, error) {
+	iterator := store.db.NewIterator(concatBytes(indexerTxPrefix, filter.TransactionHash.Bytes(), indexerLogPrefix, filter.Contract.Bytes()), nil)
+	defer iterator.Release()
+
+	logs := []types.Log{}
+	for iterator.Next() {
+		value, err := store.db.Get(iterator.Key()[len(indexerTxPrefix)+common.HashLength:])
+		if err != nil {
+			return nil, err
+		}
+
+		log := types.Log{}
+		if err := json.Unmarshal(value, &log); err != nil {
+			return nil, err
+		}
+
+		if matchesIndexerLogFilter(log, filter) {
+			logs = append(logs, log)
+		}
+	}
+
+	return logs, iterator.Error()
+}
+
+func (store *kvIndexerStore) Close() error {
+	return store.db.Close()
+}
+
+// Check whether a log matches the block range, transaction hash and topics of a filter
+func matchesIndexerLogFilter(log types.Log, filter IndexerLogFilter) bool {
+	if log.BlockNumber < filter.FromBlock || (filter.ToBlock != nil && log.BlockNumber > *filter.ToBlock) {
+		return false
+	}
+	if filter.TransactionHash != nil && log.TxHash != *filter.TransactionHash {
+		return false
+	}
+	if len(log.Topics) < len(filter.Topics) {
+		return false
+	}
+
+	for i, topics := range filter.Topics {
+		if len(topics) == 0 {
+			continue
+		}
+
+		matches := false
+		for _, topic := range topics {
+			if log.Topics[i] == topic {
+				matches = true
+				break
+			}
+		}
+		if !matches {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Key of a log, ordering the logs of a contract by block number and log index
+func getIndexerLogKey(contract common.Address, blockNumber uint64, index uint) []byte {
+	position := make([]byte, 12)
+	binary.BigEndian.PutUint64(position, blockNumber)
+	binary.BigEndian.PutUint32(position[8:], uint32(index))
+
+	return concatBytes(indexerLogPrefix, contract.Bytes(), position)
+}
+
+func concatBytes(parts ...[]byte) []byte {
+	return bytes.Join(parts, nil)
+}
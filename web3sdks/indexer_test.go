@@ -0,0 +1,292 @@
+package web3sdks
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/web3sdks/go-sdk/v2/abi"
+)
+
+// Deploy a contract on a simulated backend that emits a Transfer log of the token passed as call
+// data to the caller, with the deployer's transactor to call it
+func deploySimulatedTransferEmitter(t *testing.T) (*backends.SimulatedBackend, *bind.BoundContract, *bind.TransactOpts) {
+	key, err := crypto.GenerateKey()
+	assert.Nil(t, err)
+	opts, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+	assert.Nil(t, err)
+
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{
+		opts.From: {Balance: new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)},
+	}, 8000000)
+
+	tokenAbi, err := abi.TokenERC721MetaData.GetAbi()
+	assert.Nil(t, err)
+
+	// LOG4(0, 0, Transfer, 0, caller, calldataload(0))
+	runtime := []byte{0x60, 0x00, 0x35, 0x33, 0x60, 0x00, 0x7f}
+	runtime = append(runtime, tokenAbi.Events["Transfer"].ID.Bytes()...)
+	runtime = append(runtime, 0x60, 0x00, 0x60, 0x00, 0xa4, 0x00)
+	// Copy the runtime code after the 12 bytes of the init code to memory and return it
+	code := []byte{0x60, byte(len(runtime)), 0x60, 0x0c, 0x60, 0x00, 0x39, 0x60, byte(len(runtime)), 0x60, 0x00, 0xf3}
+	code = append(code, runtime...)
+
+	_, _, contract, err := bind.DeployContract(opts, ethabi.ABI{}, code, backend)
+	assert.Nil(t, err)
+	backend.Commit()
+
+	return backend, contract, opts
+}
+
+func TestIndexerQueriesIndexedEvents(t *testing.T) {
+	service := &mockLogsService{blockNumber: 10}
+	server := newMockHttpLogsServer(t, service)
+	defer server.Close()
+
+	for i := 0; i < 5; i++ {
+		service.mine(t, int64(i))
+	}
+
+	store := NewMemoryIndexerStore()
+	defer store.Close()
+
+	indexer := NewIndexer(store)
+	assert.Nil(t, indexer.Follow(newTestEventListenerEvents(t, server.URL), "Transfer"))
+	assert.Nil(t, indexer.Sync(context.Background()))
+
+	progress, err := indexer.GetProgress(context.Background(), secondaryWallet)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(16), progress.NextBlock)
+
+	events, err := indexer.GetEvents(context.Background(), IndexerQuery{})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{0, 1, 2, 3, 4}, getTransferTokenIds(events))
+
+	// By indexed argument
+	events, err = indexer.GetEvents(context.Background(), IndexerQuery{
+		Contract:  secondaryWallet,
+		EventName: "Transfer",
+		Filters:   map[string]interface{}{"tokenId": big.NewInt(3)},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{3}, getTransferTokenIds(events))
+	assert.Equal(t, uint64(14), events[0].Transaction.BlockNumber)
+
+	// By block range
+	toBlock := uint64(13)
+	events, err = indexer.GetEvents(context.Background(), IndexerQuery{FromBlock: 12, ToBlock: &toBlock})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1, 2}, getTransferTokenIds(events))
+
+	// By transaction hash
+	events, err = indexer.GetEvents(context.Background(), IndexerQuery{
+		TransactionHash: common.BigToHash(big.NewInt(2)).Hex(),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{2}, getTransferTokenIds(events))
+
+	_, err = indexer.GetEvents(context.Background(), IndexerQuery{Filters: map[string]interface{}{"tokenId": big.NewInt(3)}})
+	assert.NotNil(t, err)
+	_, err = indexer.GetEvents(context.Background(), IndexerQuery{Contract: adminWallet})
+	assert.NotNil(t, err)
+}
+
+func TestIndexerResumesFromFileStore(t *testing.T) {
+	service := &mockLogsService{blockNumber: 10}
+	server := newMockHttpLogsServer(t, service)
+	defer server.Close()
+
+	service.mine(t, 0)
+	service.mine(t, 1)
+	service.mine(t, 2)
+
+	path := t.TempDir()
+	store, err := NewFileIndexerStore(path)
+	assert.Nil(t, err)
+
+	// The last block isn't confirmed yet
+	indexer := NewIndexer(store, &IndexerOptions{FromBlock: 11, Confirmations: 1})
+	assert.Nil(t, indexer.Follow(newTestEventListenerEvents(t, server.URL)))
+	assert.Nil(t, indexer.Sync(context.Background()))
+	assert.Nil(t, store.Close())
+
+	service.mine(t, 3)
+
+	store, err = NewFileIndexerStore(path)
+	assert.Nil(t, err)
+	defer store.Close()
+
+	indexer = NewIndexer(store, &IndexerOptions{FromBlock: 11, Confirmations: 1})
+	assert.Nil(t, indexer.Follow(newTestEventListenerEvents(t, server.URL)))
+
+	events, err := indexer.GetEvents(context.Background(), IndexerQuery{})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{0, 1}, getTransferTokenIds(events))
+
+	assert.Nil(t, indexer.Sync(context.Background()))
+	progress, err := indexer.GetProgress(context.Background(), secondaryWallet)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(14), progress.NextBlock)
+
+	events, err = indexer.GetEvents(context.Background(), IndexerQuery{})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{0, 1, 2}, getTransferTokenIds(events))
+}
+
+func TestIndexerRejectsOtherEvents(t *testing.T) {
+	service := &mockLogsService{blockNumber: 10}
+	server := newMockHttpLogsServer(t, service)
+	defer server.Close()
+	service.mine(t, 0)
+
+	store := NewMemoryIndexerStore()
+	defer store.Close()
+
+	indexer := NewIndexer(store)
+	assert.Nil(t, indexer.Follow(newTestEventListenerEvents(t, server.URL), "Transfer", "Approval"))
+	assert.NotNil(t, indexer.Follow(newTestEventListenerEvents(t, server.URL)))
+	assert.Nil(t, indexer.Sync(context.Background()))
+
+	progress, err := indexer.GetProgress(context.Background(), secondaryWallet)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Approval", "Transfer"}, progress.EventNames)
+
+	// The same events in another order resume the progress
+	indexer = NewIndexer(store)
+	assert.Nil(t, indexer.Follow(newTestEventListenerEvents(t, server.URL), "Transfer", "Approval", "Transfer"))
+	assert.Nil(t, indexer.Sync(context.Background()))
+
+	// Other events would miss the blocks already indexed
+	indexer = NewIndexer(store)
+	assert.Nil(t, indexer.Follow(newTestEventListenerEvents(t, server.URL), "Transfer"))
+	assert.NotNil(t, indexer.Sync(context.Background()))
+
+	indexer = NewIndexer(store)
+	assert.Nil(t, indexer.Follow(newTestEventListenerEvents(t, server.URL)))
+	assert.NotNil(t, indexer.Sync(context.Background()))
+}
+
+func TestIndexerKeepsUndecodedLogs(t *testing.T) {
+	service := &mockLogsService{blockNumber: 10}
+	server := newMockHttpLogsServer(t, service)
+	defer server.Close()
+
+	// A Transfer log without the indexed token id of the ERC721 event
+	tokenAbi, err := abi.TokenERC721MetaData.GetAbi()
+	assert.Nil(t, err)
+	service.mine(t, 0)
+	service.mineLog(newMockTokenLog(0, []common.Hash{
+		tokenAbi.Events["Transfer"].ID,
+		common.HexToHash(adminWallet),
+		common.HexToHash(tertiaryWallet),
+	}, common.BigToHash(big.NewInt(100)).Bytes()))
+	service.mine(t, 1)
+
+	store := NewMemoryIndexerStore()
+	defer store.Close()
+
+	indexer := NewIndexer(store)
+	assert.Nil(t, indexer.Follow(newTestEventListenerEvents(t, server.URL)))
+	err = indexer.Sync(context.Background())
+	undecodedErr := &UndecodedLogsError{}
+	assert.ErrorAs(t, err, &undecodedErr)
+	assert.Len(t, undecodedErr.LogErrors, 1)
+
+	// The progress covers the undecoded log, which is indexed as it is
+	progress, err := indexer.GetProgress(context.Background(), secondaryWallet)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(14), progress.NextBlock)
+
+	events, err := indexer.GetEvents(context.Background(), IndexerQuery{})
+	assert.ErrorAs(t, err, &undecodedErr)
+	assert.Equal(t, uint64(12), undecodedErr.LogErrors[0].Log.BlockNumber)
+	assert.Equal(t, []int64{0, 1}, getTransferTokenIds(events))
+
+	// So that it can be decoded with the right ABI
+	helper := newTestEventListenerEvents(t, server.URL).helper
+	erc20Events, err := newContractEvents(abi.TokenERC20ABI, helper)
+	assert.Nil(t, err)
+	indexer = NewIndexer(store)
+	assert.Nil(t, indexer.Follow(erc20Events))
+
+	events, err = indexer.GetEvents(context.Background(), IndexerQuery{EventName: "Transfer"})
+	assert.ErrorAs(t, err, &undecodedErr)
+	assert.Len(t, events, 1)
+	assert.Equal(t, int64(100), events[0].Data["value"].(*big.Int).Int64())
+}
+
+func TestIndexerIndexesInBackground(t *testing.T) {
+	service := &mockLogsService{blockNumber: 10}
+	server := newMockHttpLogsServer(t, service)
+	defer server.Close()
+
+	store := NewMemoryIndexerStore()
+	defer store.Close()
+
+	indexer := NewIndexer(store, &IndexerOptions{FromBlock: 11, PollInterval: time.Millisecond * 10})
+	assert.Nil(t, indexer.Follow(newTestEventListenerEvents(t, server.URL)))
+	subscription := indexer.Start(context.Background())
+	defer subscription.Unsubscribe()
+
+	service.mine(t, 0)
+	service.mine(t, 1)
+
+	events := []ContractEvent{}
+	for i := 0; i < 200 && len(events) < 2; i++ {
+		time.Sleep(time.Millisecond * 10)
+
+		var err error
+		events, err = indexer.GetEvents(context.Background(), IndexerQuery{EventName: "Transfer"})
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, []int64{0, 1}, getTransferTokenIds(events))
+}
+
+func TestIndexerIndexesSimulatedBackend(t *testing.T) {
+	backend, contract, opts := deploySimulatedTransferEmitter(t)
+	defer backend.Close()
+
+	for i := int64(0); i < 3; i++ {
+		_, err := contract.RawTransact(opts, common.BigToHash(big.NewInt(i)).Bytes())
+		assert.Nil(t, err)
+		backend.Commit()
+	}
+
+	// The provider of the contract isn't used when the indexer has a backend
+	server := newMockRpcServer()
+	defer server.Close()
+	client, err := ethclient.Dial(server.URL)
+	assert.Nil(t, err)
+	address := crypto.CreateAddress(opts.From, 0)
+	helper, err := newContractHelper(address, NewProviderHandlerWithSigner(client, nil))
+	assert.Nil(t, err)
+	events, err := newContractEvents(abi.TokenERC721ABI, helper)
+	assert.Nil(t, err)
+
+	store := NewMemoryIndexerStore()
+	defer store.Close()
+
+	indexer := NewIndexer(store, &IndexerOptions{Backend: backend})
+	assert.Nil(t, indexer.Follow(events, "Transfer"))
+	assert.Nil(t, indexer.Sync(context.Background()))
+	assert.Equal(t, 0, server.callCount("eth_getLogs"))
+
+	progress, err := indexer.GetProgress(context.Background(), address.Hex())
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(5), progress.NextBlock)
+
+	indexed, err := indexer.GetEvents(context.Background(), IndexerQuery{EventName: "Transfer"})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{0, 1, 2}, getTransferTokenIds(indexed))
+	assert.Equal(t, opts.From, indexed[0].Data["to"])
+	assert.Equal(t, uint64(2), indexed[0].Transaction.BlockNumber)
+}
@@ -36,6 +36,7 @@ type uploadResponse struct {
 type IpfsStorage struct {
 	gatewayUrl string
 	httpClient *http.Client
+	cache      *readCache
 }
 
 func newIpfsStorage(gatewayUrl string, httpClient *http.Client) *IpfsStorage {
@@ -53,6 +54,34 @@ func newIpfsStorage(gatewayUrl string, httpClient *http.Client) *IpfsStorage {
 //
 // returns: byte data of the IPFS data at the URI
 func (ipfs *IpfsStorage) Get(ctx context.Context, uri string) ([]byte, error) {
+	if ipfs.cache == nil {
+		return ipfs.fetch(ctx, uri)
+	}
+
+	// IPFS content is addressed by its hash, so it never changes
+	policy := CachePolicyTTL
+	if strings.HasPrefix(uri, "ipfs://") {
+		policy = CachePolicyImmutable
+	}
+
+	key, err := ipfs.cache.getKey(ctx, "storage:"+uri, policy)
+	if err != nil {
+		return ipfs.fetch(ctx, uri)
+	}
+	if body, ok := ipfs.cache.get(key); ok {
+		return body, nil
+	}
+
+	body, err := ipfs.fetch(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+
+	ipfs.cache.set(key, policy, body)
+	return body, nil
+}
+
+func (ipfs *IpfsStorage) fetch(ctx context.Context, uri string) ([]byte, error) {
 	gatewayUrl := replaceHashWithGatewayUrl(uri, ipfs.gatewayUrl)
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, gatewayUrl, nil)
 	if err != nil {
@@ -145,12 +174,12 @@ func (ipfs *IpfsStorage) getUploadToken(ctx context.Context, contractAddress str
 	req.Header.Set("X-App-Name", fmt.Sprintf("CONSOLE-GO-SDK-%v", contractAddress))
 	result, err := ipfs.httpClient.Do(req)
 	if err != nil {
-		return "", err
+		return "", &UploadFailedError{UnderlyingError: err}
 	}
 
 	if result.StatusCode != http.StatusOK {
-		return "", &failedToUploadError{
-			statusCode: result.StatusCode,
+		return "", &UploadFailedError{
+			StatusCode: result.StatusCode,
 		}
 	}
 
@@ -223,11 +252,14 @@ func (ipfs *IpfsStorage) uploadBatchWithCid(
 	req.Header.Set("Content-Type", writer.FormDataContentType())
 
 	if result, err := ipfs.httpClient.Do(req); err != nil {
-		return nil, err
+		return nil, &UploadFailedError{
+			Payload:         data,
+			UnderlyingError: err,
+		}
 	} else {
 		if result.StatusCode != http.StatusOK {
-			return nil, &failedToUploadError{
-				statusCode: result.StatusCode,
+			return nil, &UploadFailedError{
+				StatusCode: result.StatusCode,
 				Payload:    data,
 			}
 		}
@@ -235,17 +267,17 @@ func (ipfs *IpfsStorage) uploadBatchWithCid(
 		var uploadMeta uploadResponse
 		bodyBytes, err := ioutil.ReadAll(result.Body)
 		if err != nil {
-			return nil, &failedToUploadError{
-				statusCode:      result.StatusCode,
+			return nil, &UploadFailedError{
+				StatusCode:      result.StatusCode,
 				Payload:         data,
 				UnderlyingError: err,
 			}
 		}
 
 		if err := json.Unmarshal(bodyBytes, &uploadMeta); err != nil {
-			return nil, &unmarshalError{
-				body:            string(bodyBytes),
-				typeName:        "UploadResponse",
+			return nil, &UnmarshalError{
+				Body:            string(bodyBytes),
+				TypeName:        "UploadResponse",
 				UnderlyingError: err,
 			}
 		}
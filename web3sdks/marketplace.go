@@ -33,13 +33,13 @@ type Marketplace struct {
 	Abi     *abi.Marketplace
 	Helper  *contractHelper
 	Encoder *MarketplaceEncoder
-	Events  *ContractEvents
+	Events  *MarketplaceEvents
 }
 
-func newMarketplace(provider *ethclient.Client, address common.Address, privateKey string, storage storage) (*Marketplace, error) {
-	if contractAbi, err := abi.NewMarketplace(address, provider); err != nil {
+func newMarketplace(provider *ethclient.Client, address common.Address, handler *ProviderHandler, storage storage) (*Marketplace, error) {
+	if contractAbi, err := abi.NewMarketplace(address, handler.getContractBackend()); err != nil {
 		return nil, err
-	} else if helper, err := newContractHelper(address, provider, privateKey); err != nil {
+	} else if helper, err := newContractHelper(address, handler); err != nil {
 		return nil, err
 	} else {
 		encoder, err := newMarketplaceEncoder(contractAbi, helper, storage)
@@ -47,7 +47,7 @@ func newMarketplace(provider *ethclient.Client, address common.Address, privateK
 			return nil, err
 		}
 
-		events, err := newContractEvents(abi.MarketplaceABI, helper)
+		events, err := newMarketplaceEvents(contractAbi, helper)
 		if err != nil {
 			return nil, err
 		}
@@ -83,7 +83,7 @@ func (marketplace *Marketplace) GetListing(ctx context.Context, listingId int) (
 
 	// If listing does not exist or is cancelled, return nil as the listing
 	if listing.AssetContract.String() == zeroAddress {
-		return nil, fmt.Errorf("Failed to find listing with ID %d", listingId)
+		return nil, &NotFoundError{TypeName: "listing", Identifier: listingId}
 	}
 
 	if listing.ListingType == 0 {
@@ -97,7 +97,8 @@ func (marketplace *Marketplace) GetListing(ctx context.Context, listingId int) (
 //
 // filter: optional filter parameters
 //
-// returns: all active listings in the marketplace
+// returns: all active listings in the marketplace. When the assets of some listings can't be fetched, all the
+// listings are returned along with a *PartialListingsError
 //
 // Example
 //
@@ -105,7 +106,7 @@ func (marketplace *Marketplace) GetListing(ctx context.Context, listingId int) (
 //	// Price per token of the first listing
 //	listings[0].BuyoutCurrencyValuePerToken.DisplayValue
 func (marketplace *Marketplace) GetActiveListings(ctx context.Context, filter *MarketplaceFilter) ([]*DirectListing, error) {
-	listings, err := marketplace.getAllListingsNoFilter(ctx)
+	listings, partialErr, err := marketplace.getAllListingsNoFilter(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -122,14 +123,15 @@ func (marketplace *Marketplace) GetActiveListings(ctx context.Context, filter *M
 		}
 	}
 
-	return activeListings, nil
+	return activeListings, filterPartialListingsError(partialErr, activeListings)
 }
 
 // Get all the listings from the marketplace.
 //
 // filter: optional filter parameters
 //
-// returns: all listings in the marketplace
+// returns: all listings in the marketplace. When the assets of some listings can't be fetched, all the
+// listings are returned along with a *PartialListingsError
 //
 // Example
 //
@@ -137,12 +139,17 @@ func (marketplace *Marketplace) GetActiveListings(ctx context.Context, filter *M
 //	// Price per token of the first listing
 //	listings[0].BuyoutCurrencyValuePerToken.DisplayValue
 func (marketplace *Marketplace) GetAllListings(ctx context.Context, filter *MarketplaceFilter) ([]*DirectListing, error) {
-	listings, err := marketplace.getAllListingsNoFilter(ctx)
+	listings, partialErr, err := marketplace.getAllListingsNoFilter(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return marketplace.applyFilter(listings, filter)
+	listings, err = marketplace.applyFilter(listings, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return listings, filterPartialListingsError(partialErr, listings)
 }
 
 // Get the total number of listings in the marketplace.
@@ -169,18 +176,29 @@ func (marketplace *Marketplace) GetTotalCount(ctx context.Context) (int, error)
 //
 //	listingId := 0
 //	receipt, err := marketplace.CancelListing(context.Background(), listingId)
-func (marketplace *Marketplace) CancelListing(ctx context.Context, listingId int) (*types.Transaction, error) {
-	txOpts, err := marketplace.Helper.GetTxOptions(ctx)
+func (marketplace *Marketplace) CancelListing(ctx context.Context, listingId int) (*TransactionResult, error) {
+	tx, err := marketplace.PrepareCancelListing(ctx, listingId)
 	if err != nil {
 		return nil, err
 	}
 
-	tx, err := marketplace.Abi.CancelDirectListing(txOpts, big.NewInt(int64(listingId)))
-	if err != nil {
-		return nil, err
-	}
+	return tx.SendAndWait(ctx)
+}
 
-	return marketplace.Helper.AwaitTx(ctx, tx.Hash())
+// Prepare the cancellation of a listing on the marketplace without sending it.
+//
+// listingId: listing ID to cancel
+//
+// returns: the prepared cancellation transaction
+//
+// Example
+//
+//	tx, err := marketplace.PrepareCancelListing(context.Background(), 0)
+//	_, err = tx.Simulate(context.Background())
+func (marketplace *Marketplace) PrepareCancelListing(ctx context.Context, listingId int) (*PreparedTx, error) {
+	return newPreparedTx(marketplace.Helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return marketplace.Abi.CancelDirectListing(opts, big.NewInt(int64(listingId)))
+	}), nil
 }
 
 // Buy a specific listing from the marketplace.
@@ -190,10 +208,21 @@ func (marketplace *Marketplace) CancelListing(ctx context.Context, listingId int
 // quantityDesired: the quantity of the asset to buy from the listing
 //
 // returns: transaction receipt of the purchase
-func (marketplace *Marketplace) BuyoutListing(ctx context.Context, listingId int, quantityDesired int) (*types.Transaction, error) {
+func (marketplace *Marketplace) BuyoutListing(ctx context.Context, listingId int, quantityDesired int) (*TransactionResult, error) {
 	return marketplace.BuyoutListingTo(ctx, listingId, quantityDesired, marketplace.Helper.GetSignerAddress().Hex())
 }
 
+// Prepare the purchase of a specific listing from the marketplace without sending it.
+//
+// listingId: listing ID of the asset you want to buy
+//
+// quantityDesired: the quantity of the asset to buy from the listing
+//
+// returns: the prepared purchase transaction
+func (marketplace *Marketplace) PrepareBuyoutListing(ctx context.Context, listingId int, quantityDesired int) (*PreparedTx, error) {
+	return marketplace.PrepareBuyoutListingTo(ctx, listingId, quantityDesired, marketplace.Helper.GetSignerAddress().Hex())
+}
+
 // Buy a specific listing from the marketplace to a specific address.
 //
 // listingId: listing ID of the asset you want to buy
@@ -210,7 +239,32 @@ func (marketplace *Marketplace) BuyoutListing(ctx context.Context, listingId int
 //	quantityDesired := 1
 //	receiver := "0x..."
 //	receipt, err := marketplace.BuyoutListingTo(context.Background(), listingId, quantityDesired, receiver)
-func (marketplace *Marketplace) BuyoutListingTo(ctx context.Context, listingId int, quantityDesired int, receiver string) (*types.Transaction, error) {
+func (marketplace *Marketplace) BuyoutListingTo(ctx context.Context, listingId int, quantityDesired int, receiver string) (*TransactionResult, error) {
+	tx, err := marketplace.PrepareBuyoutListingTo(ctx, listingId, quantityDesired, receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the purchase of a specific listing from the marketplace to a specific address without
+// sending it. If the listing is paid in an ERC20 token, the allowance is approved when the
+// transaction is sent.
+//
+// listingId: listing ID of the asset you want to buy
+//
+// quantityDesired: the quantity of the asset to buy from the listing
+//
+// receiver: specific address to receive the assets from the listing
+//
+// returns: the prepared purchase transaction
+//
+// Example
+//
+//	tx, err := marketplace.PrepareBuyoutListingTo(context.Background(), 0, 1, "0x...")
+//	estimate, err := tx.Estimate(context.Background())
+func (marketplace *Marketplace) PrepareBuyoutListingTo(ctx context.Context, listingId int, quantityDesired int, receiver string) (*PreparedTx, error) {
 	listing, err := marketplace.validateListing(ctx, listingId)
 	if err != nil {
 		return nil, err
@@ -226,37 +280,20 @@ func (marketplace *Marketplace) BuyoutListingTo(ctx context.Context, listingId i
 	}
 
 	quantity := big.NewInt(int64(quantityDesired))
-	value := listing.BuyoutCurrencyValuePerToken.Value.Mul(listing.BuyoutCurrencyValuePerToken.Value, quantity)
-
-	txOpts, err := marketplace.Helper.GetTxOptions(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	err = setErc20Allowance(
-		ctx,
-		marketplace.Helper,
-		value,
-		listing.CurrencyContractAddress,
-		txOpts,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	tx, err := marketplace.Abi.Buy(
-		txOpts,
-		big.NewInt(int64(listingId)),
-		common.HexToAddress(receiver),
-		big.NewInt(int64(quantityDesired)),
-		common.HexToAddress(listing.CurrencyContractAddress),
-		value,
-	)
-	if err != nil {
-		return nil, err
-	}
+	value := big.NewInt(0).Mul(listing.BuyoutCurrencyValuePerToken.Value, quantity)
+
+	tx := newPreparedTx(marketplace.Helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return marketplace.Abi.Buy(
+			opts,
+			big.NewInt(int64(listingId)),
+			common.HexToAddress(receiver),
+			big.NewInt(int64(quantityDesired)),
+			common.HexToAddress(listing.CurrencyContractAddress),
+			value,
+		)
+	})
 
-	return marketplace.Helper.AwaitTx(ctx, tx.Hash())
+	return tx.withPayment(value, listing.CurrencyContractAddress), nil
 }
 
 // Create a new listing on the marketplace where people can buy an asset directly.
@@ -279,55 +316,17 @@ func (marketplace *Marketplace) BuyoutListingTo(ctx context.Context, listingId i
 //
 //	listingId, err := marketplace.CreateListing(context.Background(), listing)
 func (marketplace *Marketplace) CreateListing(ctx context.Context, listing *NewDirectListing) (int, error) {
-	listing.fillDefaults()
-
-	err := handleTokenApproval(
-		ctx,
-		marketplace.Helper.GetProvider(),
-		marketplace.Helper,
-		marketplace.Helper.getAddress().Hex(),
-		listing.AssetContractAddress,
-		listing.TokenId,
-		marketplace.Helper.GetSignerAddress().Hex(),
-	)
+	tx, err := marketplace.PrepareCreateListing(ctx, listing)
 	if err != nil {
 		return 0, err
 	}
 
-	normalizedPricePerToken, err := normalizePriceValue(
-		ctx,
-		marketplace.Helper.GetProvider(),
-		listing.BuyoutPricePerToken,
-		listing.CurrencyContractAddress,
-	)
+	result, err := tx.SendAndWait(ctx)
 	if err != nil {
 		return 0, err
 	}
 
-	txOpts, err := marketplace.Helper.GetTxOptions(ctx)
-	if err != nil {
-		return 0, err
-	}
-	tx, err := marketplace.Abi.CreateListing(txOpts, abi.IMarketplaceListingParameters{
-		AssetContract:        common.HexToAddress(listing.AssetContractAddress),
-		TokenId:              big.NewInt(int64(listing.TokenId)),
-		StartTime:            big.NewInt(int64(listing.StartTimeInEpochSeconds)),
-		SecondsUntilEndTime:  big.NewInt(int64(listing.ListingDurationInSeconds)),
-		QuantityToList:       big.NewInt(int64(listing.Quantity)),
-		CurrencyToAccept:     common.HexToAddress(listing.CurrencyContractAddress),
-		ReservePricePerToken: normalizedPricePerToken,
-		BuyoutPricePerToken:  normalizedPricePerToken,
-		ListingType:          0,
-	})
-
-	receipt, err := marketplace.Helper.AwaitTx(ctx, tx.Hash())
-
-	txReceipt, err := marketplace.Helper.GetProvider().TransactionReceipt(ctx, receipt.Hash())
-	if err != nil {
-		return 0, err
-	}
-
-	for _, log := range txReceipt.Logs {
+	for _, log := range result.Receipt.Logs {
 		event, err := marketplace.Abi.ParseListingAdded(*log)
 		if err != nil {
 			continue
@@ -339,6 +338,61 @@ func (marketplace *Marketplace) CreateListing(ctx context.Context, listing *NewD
 	return 0, errors.New("No ListingAdded event found")
 }
 
+// Prepare the creation of a new direct listing on the marketplace without sending it. If the
+// marketplace isn't approved to transfer the listed asset yet, the approval is sent when the
+// transaction is sent.
+//
+// listing: the data for the listing to create
+//
+// returns: the prepared listing transaction
+//
+// Example
+//
+//	tx, err := marketplace.PrepareCreateListing(context.Background(), listing)
+//	estimate, err := tx.Estimate(context.Background())
+func (marketplace *Marketplace) PrepareCreateListing(ctx context.Context, listing *NewDirectListing) (*PreparedTx, error) {
+	listing.fillDefaults()
+
+	normalizedPricePerToken, err := normalizePriceValue(
+		ctx,
+		marketplace.Helper.getContractBackend(),
+		listing.BuyoutPricePerToken,
+		listing.BuyoutPricePerTokenString,
+		listing.BuyoutPricePerTokenBigInt,
+		listing.CurrencyContractAddress,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := newPreparedTx(marketplace.Helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return marketplace.Abi.CreateListing(opts, abi.IMarketplaceListingParameters{
+			AssetContract:        common.HexToAddress(listing.AssetContractAddress),
+			TokenId:              big.NewInt(int64(listing.TokenId)),
+			StartTime:            big.NewInt(int64(listing.StartTimeInEpochSeconds)),
+			SecondsUntilEndTime:  big.NewInt(int64(listing.ListingDurationInSeconds)),
+			QuantityToList:       big.NewInt(int64(listing.Quantity)),
+			CurrencyToAccept:     common.HexToAddress(listing.CurrencyContractAddress),
+			ReservePricePerToken: normalizedPricePerToken,
+			BuyoutPricePerToken:  normalizedPricePerToken,
+			ListingType:          0,
+		})
+	})
+	tx.beforeSend = func(ctx context.Context) error {
+		return handleTokenApproval(
+			ctx,
+			marketplace.Helper.GetProvider(),
+			marketplace.Helper,
+			marketplace.Helper.getAddress().Hex(),
+			listing.AssetContractAddress,
+			listing.TokenId,
+			marketplace.Helper.GetSignerAddress().Hex(),
+		)
+	}
+
+	return tx, nil
+}
+
 func (marketplace *Marketplace) validateListing(ctx context.Context, listingId int) (*DirectListing, error) {
 	listing, err := marketplace.GetListing(ctx, listingId)
 	if err != nil {
@@ -348,28 +402,51 @@ func (marketplace *Marketplace) validateListing(ctx context.Context, listingId i
 	return listing, nil
 }
 
-func (marketplace *Marketplace) getAllListingsNoFilter(ctx context.Context) ([]*DirectListing, error) {
+// Get all the direct listings, along with the errors of the listings whose asset couldn't be fetched
+func (marketplace *Marketplace) getAllListingsNoFilter(ctx context.Context) ([]*DirectListing, *PartialListingsError, error) {
 	totalCount, err := marketplace.Abi.TotalListings(&bind.CallOpts{
 		Context: ctx,
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	contractAbi, err := abi.MarketplaceMetaData.GetAbi()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	listings := []*DirectListing{}
+	// read all listings in as few requests as possible
+	reads := []*contractRead{}
 	for id := 0; id < int(totalCount.Int64()); id++ {
-		listing, err := marketplace.GetListing(ctx, id)
+		read, err := newContractRead(marketplace.Helper.getAddress(), contractAbi, "listings", big.NewInt(int64(id)))
 		if err != nil {
-			if strings.Contains(err.Error(), "Failed to find listing") || strings.Contains(err.Error(), "Unsupported listing type") {
-				continue
-			} else {
-				return nil, err
-			}
+			return nil, nil, err
+		}
+		reads = append(reads, read)
+	}
+
+	listings := []abi.IMarketplaceListing{}
+	for _, result := range marketplace.Helper.batchRead(ctx, reads) {
+		listing := abi.IMarketplaceListing{}
+		if err := result.unpackInto(&listing); err != nil {
+			return nil, nil, err
+		}
+
+		// Skip the listings that don't exist or were cancelled, and the unsupported auction listings
+		if listing.AssetContract.String() == zeroAddress || listing.ListingType != 0 {
+			continue
 		}
 		listings = append(listings, listing)
 	}
 
-	return listings, nil
+	directListings, err := mapListings(ctx, marketplace.Helper, marketplace.storage, listings)
+	var partialErr *PartialListingsError
+	if err != nil && !errors.As(err, &partialErr) {
+		return nil, nil, err
+	}
+
+	return directListings, partialErr, nil
 }
 
 func (marketplace *Marketplace) applyFilter(listings []*DirectListing, filter *MarketplaceFilter) ([]*DirectListing, error) {
@@ -79,12 +79,9 @@ func newMarketplaceEncoder(contractAbi *abi.Marketplace, helper *contractHelper,
 //	fmt.Println(tx.Data()) // Ex: get the data field or the nonce field (others are available)
 //	fmt.Println(tx.Nonce())
 func (encoder *MarketplaceEncoder) CancelListing(ctx context.Context, signerAddress string, listingId int) (*types.Transaction, error) {
-	txOpts, err := encoder.helper.getUnsignedTxOptions(ctx, signerAddress)
-	if err != nil {
-		return nil, err
-	}
-
-	return encoder.abi.CancelDirectListing(txOpts, big.NewInt(int64(listingId)))
+	return encoder.encode(ctx, signerAddress, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return encoder.abi.CancelDirectListing(opts, big.NewInt(int64(listingId)))
+	})
 }
 
 // Get the transaction data to approve the tokens needed for a buyout listing transaction. If the transaction
@@ -214,15 +211,20 @@ func (encoder *MarketplaceEncoder) BuyoutListing(
 		return nil, err
 	}
 
-	txOpts, err := encoder.helper.getUnsignedTxOptions(ctx, signerAddress)
-	return encoder.abi.Buy(
-		txOpts,
-		big.NewInt(int64(listingId)),
-		common.HexToAddress(receiver),
-		big.NewInt(int64(quantityDesired)),
-		common.HexToAddress(listing.CurrencyContractAddress),
-		value,
-	)
+	return encoder.encode(ctx, signerAddress, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		if isNativeToken(listing.CurrencyContractAddress) {
+			opts.Value = value
+		}
+
+		return encoder.abi.Buy(
+			opts,
+			big.NewInt(int64(listingId)),
+			common.HexToAddress(receiver),
+			big.NewInt(int64(quantityDesired)),
+			common.HexToAddress(listing.CurrencyContractAddress),
+			value,
+		)
+	})
 }
 
 // Get the transaction data to approve the tokens needed for a create liting transaction. If the transaction
@@ -319,29 +321,28 @@ func (encoder *MarketplaceEncoder) CreateListing(ctx context.Context, signerAddr
 
 	normalizedPricePerToken, err := normalizePriceValue(
 		ctx,
-		encoder.helper.GetProvider(),
+		encoder.helper.getContractBackend(),
 		listing.BuyoutPricePerToken,
+		listing.BuyoutPricePerTokenString,
+		listing.BuyoutPricePerTokenBigInt,
 		listing.CurrencyContractAddress,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	txOpts, err := encoder.helper.getUnsignedTxOptions(ctx, signerAddress)
-	if err != nil {
-		return nil, err
-	}
-
-	return encoder.abi.CreateListing(txOpts, abi.IMarketplaceListingParameters{
-		AssetContract:        common.HexToAddress(listing.AssetContractAddress),
-		TokenId:              big.NewInt(int64(listing.TokenId)),
-		StartTime:            big.NewInt(int64(listing.StartTimeInEpochSeconds)),
-		SecondsUntilEndTime:  big.NewInt(int64(listing.ListingDurationInSeconds)),
-		QuantityToList:       big.NewInt(int64(listing.Quantity)),
-		CurrencyToAccept:     common.HexToAddress(listing.CurrencyContractAddress),
-		ReservePricePerToken: normalizedPricePerToken,
-		BuyoutPricePerToken:  normalizedPricePerToken,
-		ListingType:          0,
+	return encoder.encode(ctx, signerAddress, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return encoder.abi.CreateListing(opts, abi.IMarketplaceListingParameters{
+			AssetContract:        common.HexToAddress(listing.AssetContractAddress),
+			TokenId:              big.NewInt(int64(listing.TokenId)),
+			StartTime:            big.NewInt(int64(listing.StartTimeInEpochSeconds)),
+			SecondsUntilEndTime:  big.NewInt(int64(listing.ListingDurationInSeconds)),
+			QuantityToList:       big.NewInt(int64(listing.Quantity)),
+			CurrencyToAccept:     common.HexToAddress(listing.CurrencyContractAddress),
+			ReservePricePerToken: normalizedPricePerToken,
+			BuyoutPricePerToken:  normalizedPricePerToken,
+			ListingType:          0,
+		})
 	})
 }
 
@@ -352,7 +353,7 @@ func (encoder *MarketplaceEncoder) validateListing(ctx context.Context, listingI
 	}
 
 	if listing.AssetContract.String() == zeroAddress {
-		return nil, fmt.Errorf("Failed to find listing with ID %d", listingId)
+		return nil, &NotFoundError{TypeName: "listing", Identifier: listingId}
 	}
 
 	if listing.ListingType == 0 {
@@ -526,13 +527,10 @@ func (encoder *MarketplaceEncoder) handleTokenApproval(
 				return nil, err
 			}
 
-			txOpts, err := helper.getUnsignedTxOptions(ctx, signerAddress)
-			if err != nil {
-				return nil, err
-			}
-
 			if strings.ToLower(tokenApproved.String()) != strings.ToLower(marketplaceAddress) {
-				return contract.SetApprovalForAll(txOpts, common.HexToAddress(marketplaceAddress), true)
+				return encoder.encode(ctx, signerAddress, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+					return contract.SetApprovalForAll(opts, common.HexToAddress(marketplaceAddress), true)
+				})
 			}
 		}
 	} else if isErc1155 {
@@ -547,12 +545,9 @@ func (encoder *MarketplaceEncoder) handleTokenApproval(
 		}
 
 		if !approved {
-			txOpts, err := helper.getUnsignedTxOptions(ctx, signerAddress)
-			if err != nil {
-				return nil, err
-			}
-
-			return contract.SetApprovalForAll(txOpts, common.HexToAddress(marketplaceAddress), true)
+			return encoder.encode(ctx, signerAddress, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+				return contract.SetApprovalForAll(opts, common.HexToAddress(marketplaceAddress), true)
+			})
 		}
 	} else {
 		return nil, errors.New("Contract does not implement ERC721 or ERC1155")
@@ -0,0 +1,433 @@
+package web3sdks
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/web3sdks/go-sdk/v2/abi"
+)
+
+// The events of the marketplace contract, decoded with the generated bindings of the contract into
+// typed events instead of the generic ContractEvent.
+//
+// All the methods of ContractEvents are available as well, like GetEvents or AddEventListener.
+//
+// Example
+//
+//	contract, err := sdk.GetMarketplace("{{contract_address}}")
+//
+//	listings, err := contract.Events.GetListingsAdded(context.Background(), web3sdks.EventQueryOptions{})
+//	fmt.Println(listings[0].ListingId)
+type MarketplaceEvents struct {
+	*ContractEvents
+	filterer *abi.MarketplaceFilterer
+}
+
+func newMarketplaceEvents(contractAbi *abi.Marketplace, helper *contractHelper) (*MarketplaceEvents, error) {
+	events, err := newContractEvents(abi.MarketplaceABI, helper)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MarketplaceEvents{
+		ContractEvents: events,
+		filterer:       &contractAbi.MarketplaceFilterer,
+	}, nil
+}
+
+// Query the past listings added.
+//
+// options: The options to use when querying for events, including block range specifications and
+// filters on the indexed arguments of the ListingAdded event
+//
+// returns: the ListingAdded events that match the query, in the order of the options
+//
+// Example
+//
+//	listings, err := contract.Events.GetListingsAdded(context.Background(), web3sdks.EventQueryOptions{
+//	  FromBlock: 100000000,
+//	})
+//
+//	for _, listing := range listings {
+//	  fmt.Println(listing.ListingId, listing.Raw.BlockNumber)
+//	}
+func (events *MarketplaceEvents) GetListingsAdded(ctx context.Context, options EventQueryOptions) ([]*abi.MarketplaceListingAdded, error) {
+	listings := []*abi.MarketplaceListingAdded{}
+	err := events.queryLogs(ctx, "ListingAdded", options, func(log types.Log) error {
+		listing, err := events.filterer.ParseListingAdded(log)
+		if err != nil {
+			return err
+		}
+
+		listings = append(listings, listing)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return listings, nil
+}
+
+// Listen in the background for the future listings added, with the same options as AddEventListener.
+//
+// listener: The listener function that will be called with every new ListingAdded event. The events
+// removed by a reorg are delivered again with Raw.Removed set to true
+//
+// options: Optional options of the listener, like the poll interval or the block to start listening from
+//
+// returns: An EventSubscription object that can be used to unsubscribe from the event or check for errors
+//
+// Example
+//
+//	subscription := contract.Events.OnListingAdded(context.Background(), func(listing *abi.MarketplaceListingAdded) {
+//	  fmt.Println(listing.ListingId)
+//	})
+//
+//	subscription.Unsubscribe()
+func (events *MarketplaceEvents) OnListingAdded(
+	ctx context.Context,
+	listener func(event *abi.MarketplaceListingAdded),
+	options ...*EventListenerOptions,
+) EventSubscription {
+	return events.listenLogs(ctx, "ListingAdded", func(log types.Log) error {
+		listing, err := events.filterer.ParseListingAdded(log)
+		if err != nil {
+			return err
+		}
+
+		listener(listing)
+		return nil
+	}, options)
+}
+
+// Query the past listings updated.
+//
+// options: The options to use when querying for events, including block range specifications and
+// filters on the indexed arguments of the ListingUpdated event
+//
+// returns: the ListingUpdated events that match the query, in the order of the options
+//
+// Example
+//
+//	listings, err := contract.Events.GetListingsUpdated(context.Background(), web3sdks.EventQueryOptions{
+//	  FromBlock: 100000000,
+//	})
+//
+//	for _, listing := range listings {
+//	  fmt.Println(listing.ListingId, listing.Raw.BlockNumber)
+//	}
+func (events *MarketplaceEvents) GetListingsUpdated(ctx context.Context, options EventQueryOptions) ([]*abi.MarketplaceListingUpdated, error) {
+	listings := []*abi.MarketplaceListingUpdated{}
+	err := events.queryLogs(ctx, "ListingUpdated", options, func(log types.Log) error {
+		listing, err := events.filterer.ParseListingUpdated(log)
+		if err != nil {
+			return err
+		}
+
+		listings = append(listings, listing)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return listings, nil
+}
+
+// Listen in the background for the future listings updated, with the same options as AddEventListener.
+//
+// listener: The listener function that will be called with every new ListingUpdated event. The events
+// removed by a reorg are delivered again with Raw.Removed set to true
+//
+// options: Optional options of the listener, like the poll interval or the block to start listening from
+//
+// returns: An EventSubscription object that can be used to unsubscribe from the event or check for errors
+//
+// Example
+//
+//	subscription := contract.Events.OnListingUpdated(context.Background(), func(listing *abi.MarketplaceListingUpdated) {
+//	  fmt.Println(listing.ListingId)
+//	})
+//
+//	subscription.Unsubscribe()
+func (events *MarketplaceEvents) OnListingUpdated(
+	ctx context.Context,
+	listener func(event *abi.MarketplaceListingUpdated),
+	options ...*EventListenerOptions,
+) EventSubscription {
+	return events.listenLogs(ctx, "ListingUpdated", func(log types.Log) error {
+		listing, err := events.filterer.ParseListingUpdated(log)
+		if err != nil {
+			return err
+		}
+
+		listener(listing)
+		return nil
+	}, options)
+}
+
+// Query the past listings removed.
+//
+// options: The options to use when querying for events, including block range specifications and
+// filters on the indexed arguments of the ListingRemoved event
+//
+// returns: the ListingRemoved events that match the query, in the order of the options
+//
+// Example
+//
+//	listings, err := contract.Events.GetListingsRemoved(context.Background(), web3sdks.EventQueryOptions{
+//	  FromBlock: 100000000,
+//	})
+//
+//	for _, listing := range listings {
+//	  fmt.Println(listing.ListingId, listing.Raw.BlockNumber)
+//	}
+func (events *MarketplaceEvents) GetListingsRemoved(ctx context.Context, options EventQueryOptions) ([]*abi.MarketplaceListingRemoved, error) {
+	listings := []*abi.MarketplaceListingRemoved{}
+	err := events.queryLogs(ctx, "ListingRemoved", options, func(log types.Log) error {
+		listing, err := events.filterer.ParseListingRemoved(log)
+		if err != nil {
+			return err
+		}
+
+		listings = append(listings, listing)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return listings, nil
+}
+
+// Listen in the background for the future listings removed, with the same options as AddEventListener.
+//
+// listener: The listener function that will be called with every new ListingRemoved event. The events
+// removed by a reorg are delivered again with Raw.Removed set to true
+//
+// options: Optional options of the listener, like the poll interval or the block to start listening from
+//
+// returns: An EventSubscription object that can be used to unsubscribe from the event or check for errors
+//
+// Example
+//
+//	subscription := contract.Events.OnListingRemoved(context.Background(), func(listing *abi.MarketplaceListingRemoved) {
+//	  fmt.Println(listing.ListingId)
+//	})
+//
+//	subscription.Unsubscribe()
+func (events *MarketplaceEvents) OnListingRemoved(
+	ctx context.Context,
+	listener func(event *abi.MarketplaceListingRemoved),
+	options ...*EventListenerOptions,
+) EventSubscription {
+	return events.listenLogs(ctx, "ListingRemoved", func(log types.Log) error {
+		listing, err := events.filterer.ParseListingRemoved(log)
+		if err != nil {
+			return err
+		}
+
+		listener(listing)
+		return nil
+	}, options)
+}
+
+// Query the past sales of direct listings.
+//
+// options: The options to use when querying for events, including block range specifications and
+// filters on the indexed arguments of the NewSale event
+//
+// returns: the NewSale events that match the query, in the order of the options
+//
+// Example
+//
+//	sales, err := contract.Events.GetNewSales(context.Background(), web3sdks.EventQueryOptions{
+//	  FromBlock: 100000000,
+//	})
+//
+//	for _, sale := range sales {
+//	  fmt.Println(sale.TotalPricePaid, sale.Raw.BlockNumber)
+//	}
+func (events *MarketplaceEvents) GetNewSales(ctx context.Context, options EventQueryOptions) ([]*abi.MarketplaceNewSale, error) {
+	sales := []*abi.MarketplaceNewSale{}
+	err := events.queryLogs(ctx, "NewSale", options, func(log types.Log) error {
+		sale, err := events.filterer.ParseNewSale(log)
+		if err != nil {
+			return err
+		}
+
+		sales = append(sales, sale)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sales, nil
+}
+
+// Listen in the background for the future sales of direct listings, with the same options as AddEventListener.
+//
+// listener: The listener function that will be called with every new NewSale event. The events
+// removed by a reorg are delivered again with Raw.Removed set to true
+//
+// options: Optional options of the listener, like the poll interval or the block to start listening from
+//
+// returns: An EventSubscription object that can be used to unsubscribe from the event or check for errors
+//
+// Example
+//
+//	subscription := contract.Events.OnNewSale(context.Background(), func(sale *abi.MarketplaceNewSale) {
+//	  fmt.Println(sale.TotalPricePaid)
+//	})
+//
+//	subscription.Unsubscribe()
+func (events *MarketplaceEvents) OnNewSale(
+	ctx context.Context,
+	listener func(event *abi.MarketplaceNewSale),
+	options ...*EventListenerOptions,
+) EventSubscription {
+	return events.listenLogs(ctx, "NewSale", func(log types.Log) error {
+		sale, err := events.filterer.ParseNewSale(log)
+		if err != nil {
+			return err
+		}
+
+		listener(sale)
+		return nil
+	}, options)
+}
+
+// Query the past offers and auction bids.
+//
+// options: The options to use when querying for events, including block range specifications and
+// filters on the indexed arguments of the NewOffer event
+//
+// returns: the NewOffer events that match the query, in the order of the options
+//
+// Example
+//
+//	offers, err := contract.Events.GetNewOffers(context.Background(), web3sdks.EventQueryOptions{
+//	  FromBlock: 100000000,
+//	})
+//
+//	for _, offer := range offers {
+//	  fmt.Println(offer.TotalOfferAmount, offer.Raw.BlockNumber)
+//	}
+func (events *MarketplaceEvents) GetNewOffers(ctx context.Context, options EventQueryOptions) ([]*abi.MarketplaceNewOffer, error) {
+	offers := []*abi.MarketplaceNewOffer{}
+	err := events.queryLogs(ctx, "NewOffer", options, func(log types.Log) error {
+		offer, err := events.filterer.ParseNewOffer(log)
+		if err != nil {
+			return err
+		}
+
+		offers = append(offers, offer)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return offers, nil
+}
+
+// Listen in the background for the future offers and auction bids, with the same options as AddEventListener.
+//
+// listener: The listener function that will be called with every new NewOffer event. The events
+// removed by a reorg are delivered again with Raw.Removed set to true
+//
+// options: Optional options of the listener, like the poll interval or the block to start listening from
+//
+// returns: An EventSubscription object that can be used to unsubscribe from the event or check for errors
+//
+// Example
+//
+//	subscription := contract.Events.OnNewOffer(context.Background(), func(offer *abi.MarketplaceNewOffer) {
+//	  fmt.Println(offer.TotalOfferAmount)
+//	})
+//
+//	subscription.Unsubscribe()
+func (events *MarketplaceEvents) OnNewOffer(
+	ctx context.Context,
+	listener func(event *abi.MarketplaceNewOffer),
+	options ...*EventListenerOptions,
+) EventSubscription {
+	return events.listenLogs(ctx, "NewOffer", func(log types.Log) error {
+		offer, err := events.filterer.ParseNewOffer(log)
+		if err != nil {
+			return err
+		}
+
+		listener(offer)
+		return nil
+	}, options)
+}
+
+// Query the past auctions closed.
+//
+// options: The options to use when querying for events, including block range specifications and
+// filters on the indexed arguments of the AuctionClosed event
+//
+// returns: the AuctionClosed events that match the query, in the order of the options
+//
+// Example
+//
+//	auctions, err := contract.Events.GetAuctionsClosed(context.Background(), web3sdks.EventQueryOptions{
+//	  FromBlock: 100000000,
+//	})
+//
+//	for _, auction := range auctions {
+//	  fmt.Println(auction.ListingId, auction.Raw.BlockNumber)
+//	}
+func (events *MarketplaceEvents) GetAuctionsClosed(ctx context.Context, options EventQueryOptions) ([]*abi.MarketplaceAuctionClosed, error) {
+	auctions := []*abi.MarketplaceAuctionClosed{}
+	err := events.queryLogs(ctx, "AuctionClosed", options, func(log types.Log) error {
+		auction, err := events.filterer.ParseAuctionClosed(log)
+		if err != nil {
+			return err
+		}
+
+		auctions = append(auctions, auction)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return auctions, nil
+}
+
+// Listen in the background for the future auctions closed, with the same options as AddEventListener.
+//
+// listener: The listener function that will be called with every new AuctionClosed event. The events
+// removed by a reorg are delivered again with Raw.Removed set to true
+//
+// options: Optional options of the listener, like the poll interval or the block to start listening from
+//
+// returns: An EventSubscription object that can be used to unsubscribe from the event or check for errors
+//
+// Example
+//
+//	subscription := contract.Events.OnAuctionClosed(context.Background(), func(auction *abi.MarketplaceAuctionClosed) {
+//	  fmt.Println(auction.ListingId)
+//	})
+//
+//	subscription.Unsubscribe()
+func (events *MarketplaceEvents) OnAuctionClosed(
+	ctx context.Context,
+	listener func(event *abi.MarketplaceAuctionClosed),
+	options ...*EventListenerOptions,
+) EventSubscription {
+	return events.listenLogs(ctx, "AuctionClosed", func(log types.Log) error {
+		auction, err := events.filterer.ParseAuctionClosed(log)
+		if err != nil {
+			return err
+		}
+
+		listener(auction)
+		return nil
+	}, options)
+}
@@ -0,0 +1,216 @@
+package web3sdks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+// Error returned by a mock RPC handler, serialized as a JSON-RPC error object
+type mockRpcError struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+func (e *mockRpcError) Error() string {
+	return e.Message
+}
+
+type mockRpcHandler func(params []json.RawMessage) (interface{}, error)
+
+type mockRpcRequest struct {
+	Id     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+// A minimal JSON-RPC server used to test the SDK against canned node responses
+type mockRpcServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	handlers map[string]mockRpcHandler
+	calls    map[string]int
+	requests int
+}
+
+func newMockRpcServer() *mockRpcServer {
+	server := &mockRpcServer{
+		handlers: map[string]mockRpcHandler{},
+		calls:    map[string]int{},
+	}
+	server.Server = httptest.NewServer(http.HandlerFunc(server.serveHTTP))
+	server.handle("eth_chainId", func(params []json.RawMessage) (interface{}, error) {
+		return hexutil.EncodeUint64(1337), nil
+	})
+
+	return server
+}
+
+func (server *mockRpcServer) handle(method string, handler mockRpcHandler) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	server.handlers[method] = handler
+}
+
+func (server *mockRpcServer) callCount(method string) int {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	return server.calls[method]
+}
+
+// Number of HTTP requests received, a batch counting as a single request
+func (server *mockRpcServer) requestCount() int {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	return server.requests
+}
+
+func (server *mockRpcServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	server.mu.Lock()
+	server.requests += 1
+	server.mu.Unlock()
+
+	requests := []*mockRpcRequest{}
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(body, &requests); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		responses := []map[string]interface{}{}
+		for _, request := range requests {
+			responses = append(responses, server.serveRequest(request))
+		}
+		json.NewEncoder(w).Encode(responses)
+		return
+	}
+
+	request := &mockRpcRequest{}
+	if err := json.Unmarshal(body, request); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	json.NewEncoder(w).Encode(server.serveRequest(request))
+}
+
+func (server *mockRpcServer) serveRequest(request *mockRpcRequest) map[string]interface{} {
+	server.mu.Lock()
+	handler, ok := server.handlers[request.Method]
+	server.calls[request.Method] += 1
+	server.mu.Unlock()
+
+	response := map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      request.Id,
+	}
+	if !ok {
+		response["error"] = &mockRpcError{Code: -32601, Message: "method not found"}
+	} else if result, err := handler(request.Params); err != nil {
+		rpcErr := &mockRpcError{}
+		if !errors.As(err, &rpcErr) {
+			rpcErr = &mockRpcError{Code: -32000, Message: err.Error()}
+		}
+		response["error"] = rpcErr
+	} else {
+		response["result"] = result
+	}
+
+	return response
+}
+
+func (server *mockRpcServer) helper(t *testing.T) *contractHelper {
+	client, err := rpc.Dial(server.URL)
+	assert.Nil(t, err)
+
+	signer, _ := NewPrivateKeySigner(adminPrivateKey)
+	handler := NewProviderHandlerWithSigner(ethclient.NewClient(client), signer)
+	handler.UpdateRpcClient(client)
+
+	helper, err := newContractHelper(common.HexToAddress(secondaryWallet), handler)
+	assert.Nil(t, err)
+
+	return helper
+}
+
+func newMockSignedTx(t *testing.T) *types.Transaction {
+	signer, _ := NewPrivateKeySigner(adminPrivateKey)
+	to := common.HexToAddress(secondaryWallet)
+	tx, err := signer.SignTx(context.Background(), types.NewTx(&types.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		Nonce:     0,
+		GasTipCap: big.NewInt(2),
+		GasFeeCap: big.NewInt(100),
+		Gas:       100000,
+		To:        &to,
+		Data:      []byte{0x01, 0x02, 0x03, 0x04},
+	}), big.NewInt(1))
+	assert.Nil(t, err)
+
+	return tx
+}
+
+// JSON of a transaction as returned by eth_getTransactionByHash, mined at the given block
+func mockMinedTxJson(tx *types.Transaction, blockNumber int64) map[string]interface{} {
+	encoded, _ := tx.MarshalJSON()
+	fields := map[string]interface{}{}
+	json.Unmarshal(encoded, &fields)
+
+	from, _ := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	fields["from"] = from
+	fields["blockNumber"] = hexutil.EncodeBig(big.NewInt(blockNumber))
+	fields["blockHash"] = common.BigToHash(big.NewInt(blockNumber))
+
+	return fields
+}
+
+func mockReceipt(tx *types.Transaction, blockNumber int64, status uint64) *types.Receipt {
+	return &types.Receipt{
+		Type:              tx.Type(),
+		Status:            status,
+		CumulativeGasUsed: 50000,
+		Logs:              []*types.Log{},
+		TxHash:            tx.Hash(),
+		GasUsed:           50000,
+		BlockHash:         common.BigToHash(big.NewInt(blockNumber)),
+		BlockNumber:       big.NewInt(blockNumber),
+	}
+}
+
+func mockHeader(blockNumber int64, baseFee int64) *types.Header {
+	return &types.Header{
+		Number:     big.NewInt(blockNumber),
+		Difficulty: big.NewInt(0),
+		BaseFee:    big.NewInt(baseFee),
+	}
+}
+
+func mockRevertError(reason string) error {
+	stringType, _ := abi.NewType("string", "", nil)
+	packed, _ := abi.Arguments{{Type: stringType}}.Pack(reason)
+	data := append(common.FromHex("0x08c379a0"), packed...)
+
+	return &mockRpcError{Code: 3, Message: "execution reverted: " + reason, Data: hexutil.Encode(data)}
+}
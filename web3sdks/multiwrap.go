@@ -34,14 +34,14 @@ type Multiwrap struct {
 	Encoder *ContractEncoder
 }
 
-func newMultiwrap(provider *ethclient.Client, address common.Address, privateKey string, storage storage) (*Multiwrap, error) {
-	if contractAbi, err := abi.NewMultiwrap(address, provider); err != nil {
+func newMultiwrap(provider *ethclient.Client, address common.Address, handler *ProviderHandler, storage storage) (*Multiwrap, error) {
+	if contractAbi, err := abi.NewMultiwrap(address, handler.getContractBackend()); err != nil {
 		return nil, err
 	} else {
-		if helper, err := newContractHelper(address, provider, privateKey); err != nil {
+		if helper, err := newContractHelper(address, handler); err != nil {
 			return nil, err
 		} else {
-			if erc721, err := newERC721(provider, address, privateKey, storage); err != nil {
+			if erc721, err := newERC721(provider, address, handler, storage); err != nil {
 				return nil, err
 			} else {
 				encoder, err := newContractEncoder(abi.MultiwrapABI, helper)
@@ -87,7 +87,7 @@ func (multiwrap *Multiwrap) GetWrappedContents(wrappedTokenId int) (*MultiwrapBu
 	for _, wrappedToken := range wrappedTokens {
 		switch wrappedToken.TokenType {
 		case 0:
-			tokenMetadata, err := fetchCurrencyMetadata(context.Background(), multiwrap.Helper.GetProvider(), wrappedToken.AssetContract.String())
+			tokenMetadata, err := fetchCurrencyMetadata(context.Background(), multiwrap.Helper.getContractBackend(), wrappedToken.AssetContract.String())
 			if err != nil {
 				return nil, err
 			}
@@ -95,6 +95,8 @@ func (multiwrap *Multiwrap) GetWrappedContents(wrappedTokenId int) (*MultiwrapBu
 			erc20Tokens = append(erc20Tokens, &MultiwrapERC20{
 				ContractAddress: wrappedToken.AssetContract.String(),
 				Quantity:        formatUnits(wrappedToken.TotalAmount, tokenMetadata.Decimals),
+				QuantityString:  formatUnitsString(wrappedToken.TotalAmount, tokenMetadata.Decimals),
+				QuantityBigInt:  wrappedToken.TotalAmount,
 			})
 			continue
 		case 1:
@@ -159,7 +161,26 @@ func (multiwrap *Multiwrap) GetWrappedContents(wrappedTokenId int) (*MultiwrapBu
 //
 //	// This will mint the wrapped token to the connected wallet
 //	tx, err := contract.Wrap(context.Background(), contents, wrappedTokenMetadata, "")
-func (multiwrap *Multiwrap) Wrap(ctx context.Context, contents *MultiwrapBundle, wrappedTokenMetadata interface{}, recipientAddress string) (*types.Transaction, error) {
+func (multiwrap *Multiwrap) Wrap(ctx context.Context, contents *MultiwrapBundle, wrappedTokenMetadata interface{}, recipientAddress string) (*TransactionResult, error) {
+	tx, err := multiwrap.PrepareWrap(ctx, contents, wrappedTokenMetadata, recipientAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the wrap of any number of ERC20, ERC721, or ERC1155 tokens into a single wrapped token
+// without sending it.
+//
+// contents: the tokens to wrap into a single wrapped token
+//
+// wrappedTokenMetadata: the NFT Metadata or URI to as the metadata for the wrapped token
+//
+// recipientAddress: the optional address to send the wrapped token to
+//
+// returns: the prepared wrap transaction
+func (multiwrap *Multiwrap) PrepareWrap(ctx context.Context, contents *MultiwrapBundle, wrappedTokenMetadata interface{}, recipientAddress string) (*PreparedTx, error) {
 	uri, ok := wrappedTokenMetadata.(string)
 	if !ok {
 		tokenMetadata, ok := wrappedTokenMetadata.(*NFTMetadataInput)
@@ -184,16 +205,9 @@ func (multiwrap *Multiwrap) Wrap(ctx context.Context, contents *MultiwrapBundle,
 		return nil, err
 	}
 
-	txOpts, err := multiwrap.Helper.GetTxOptions(ctx)
-	if err != nil {
-		return nil, err
-	}
-	tx, err := multiwrap.abi.Wrap(txOpts, tokens, uri, common.HexToAddress(recipientAddress))
-	if err != nil {
-		return nil, err
-	}
-
-	return multiwrap.Helper.AwaitTx(ctx, tx.Hash())
+	return newPreparedTx(multiwrap.Helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return multiwrap.abi.Wrap(opts, tokens, uri, common.HexToAddress(recipientAddress))
+	}), nil
 }
 
 // Unwrap a wrapped token bundle into its contents
@@ -208,21 +222,30 @@ func (multiwrap *Multiwrap) Wrap(ctx context.Context, contents *MultiwrapBundle,
 //
 //	tokenId := 0
 //	tx, err := contract.Unwrap(context.Background(), tokenId, "")
-func (multiwrap *Multiwrap) Unwrap(ctx context.Context, wrappedTokenId int, recipientAddress string) (*types.Transaction, error) {
-	if recipientAddress == "" {
-		recipientAddress = multiwrap.Helper.GetSignerAddress().String()
-	}
-
-	txOpts, err := multiwrap.Helper.GetTxOptions(ctx)
+func (multiwrap *Multiwrap) Unwrap(ctx context.Context, wrappedTokenId int, recipientAddress string) (*TransactionResult, error) {
+	tx, err := multiwrap.PrepareUnwrap(ctx, wrappedTokenId, recipientAddress)
 	if err != nil {
 		return nil, err
 	}
-	tx, err := multiwrap.abi.Unwrap(txOpts, big.NewInt(int64(wrappedTokenId)), common.HexToAddress(recipientAddress))
-	if err != nil {
-		return nil, err
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the unwrap of a wrapped token bundle into its contents without sending it.
+//
+// wrappedTokenId: the ID of the wrapped token bundle
+//
+// recipientAddress: the optional address to send the wrapped token to
+//
+// returns: the prepared unwrap transaction
+func (multiwrap *Multiwrap) PrepareUnwrap(ctx context.Context, wrappedTokenId int, recipientAddress string) (*PreparedTx, error) {
+	if recipientAddress == "" {
+		recipientAddress = multiwrap.Helper.GetSignerAddress().String()
 	}
 
-	return multiwrap.Helper.AwaitTx(ctx, tx.Hash())
+	return newPreparedTx(multiwrap.Helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return multiwrap.abi.Unwrap(opts, big.NewInt(int64(wrappedTokenId)), common.HexToAddress(recipientAddress))
+	}), nil
 }
 
 func (multiwrap *Multiwrap) toTokenStructList(ctx context.Context, contents *MultiwrapBundle) ([]abi.ITokenBundleToken, error) {
@@ -235,6 +258,8 @@ func (multiwrap *Multiwrap) toTokenStructList(ctx context.Context, contents *Mul
 			ctx,
 			provider,
 			erc20.Quantity,
+			erc20.QuantityString,
+			erc20.QuantityBigInt,
 			erc20.ContractAddress,
 		)
 		if err != nil {
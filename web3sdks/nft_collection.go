@@ -31,20 +31,20 @@ type NFTCollection struct {
 	Helper    *contractHelper
 	Signature *ERC721SignatureMinting
 	Encoder   *ContractEncoder
-	Events    *ContractEvents
+	Events    *NFTCollectionEvents
 }
 
-func newNFTCollection(provider *ethclient.Client, address common.Address, privateKey string, storage storage) (*NFTCollection, error) {
-	if contractAbi, err := abi.NewTokenERC721(address, provider); err != nil {
+func newNFTCollection(provider *ethclient.Client, address common.Address, handler *ProviderHandler, storage storage) (*NFTCollection, error) {
+	if contractAbi, err := abi.NewTokenERC721(address, handler.getContractBackend()); err != nil {
 		return nil, err
 	} else {
-		if helper, err := newContractHelper(address, provider, privateKey); err != nil {
+		if helper, err := newContractHelper(address, handler); err != nil {
 			return nil, err
 		} else {
-			if erc721, err := newERC721(provider, address, privateKey, storage); err != nil {
+			if erc721, err := newERC721(provider, address, handler, storage); err != nil {
 				return nil, err
 			} else {
-				signature, err := newERC721SignatureMinting(provider, address, privateKey, storage)
+				signature, err := newERC721SignatureMinting(provider, address, handler, storage)
 				if err != nil {
 					return nil, err
 				}
@@ -54,7 +54,7 @@ func newNFTCollection(provider *ethclient.Client, address common.Address, privat
 					return nil, err
 				}
 
-				events, err := newContractEvents(abi.TokenERC721ABI, helper)
+				events, err := newNFTCollectionEvents(contractAbi, helper)
 				if err != nil {
 					return nil, err
 				}
@@ -77,7 +77,8 @@ func newNFTCollection(provider *ethclient.Client, address common.Address, privat
 //
 // address: the address of the owner of the NFTs
 //
-// returns: the metadata of all the NFTs owned by the address
+// returns: the metadata of all the NFTs owned by the address. If some of the NFTs
+// can't be fetched, the others are returned along with a *PartialResultError
 //
 // Example
 //
@@ -126,11 +127,21 @@ func (nft *NFTCollection) GetOwnedTokenIDs(ctx context.Context, address string)
 // metadata: metadata of the NFT to mint
 //
 // returns: the transaction receipt of the mint
-func (nft *NFTCollection) Mint(ctx context.Context, metadata *NFTMetadataInput) (*types.Transaction, error) {
+func (nft *NFTCollection) Mint(ctx context.Context, metadata *NFTMetadataInput) (*TransactionResult, error) {
 	address := nft.Helper.GetSignerAddress().String()
 	return nft.MintTo(ctx, address, metadata)
 }
 
+// Prepare the mint of a new NFT to the connected wallet without sending it.
+//
+// metadata: metadata of the NFT to mint
+//
+// returns: the prepared mint transaction
+func (nft *NFTCollection) PrepareMint(ctx context.Context, metadata *NFTMetadataInput) (*PreparedTx, error) {
+	address := nft.Helper.GetSignerAddress().String()
+	return nft.PrepareMintTo(ctx, address, metadata)
+}
+
 // Mint a new NFT to the specified wallet.
 //
 // address: the wallet address to mint to
@@ -151,26 +162,41 @@ func (nft *NFTCollection) Mint(ctx context.Context, metadata *NFTMetadataInput)
 //	}
 //
 //	tx, err := contract.MintTo(context.Background(), "{{wallet_address}}", metadata)
-func (nft *NFTCollection) MintTo(ctx context.Context, address string, metadata *NFTMetadataInput) (*types.Transaction, error) {
-	uri, err := uploadOrExtractUri(ctx, metadata, nft.storage)
+func (nft *NFTCollection) MintTo(ctx context.Context, address string, metadata *NFTMetadataInput) (*TransactionResult, error) {
+	tx, err := nft.PrepareMintTo(ctx, address, metadata)
 	if err != nil {
 		return nil, err
 	}
 
-	txOpts, err := nft.Helper.GetTxOptions(ctx)
-	if err != nil {
-		return nil, err
-	}
-	tx, err := nft.abi.MintTo(
-		txOpts,
-		common.HexToAddress(address),
-		uri,
-	)
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the mint of a new NFT to the specified wallet without sending it. The metadata is
+// uploaded when preparing the transaction.
+//
+// address: the wallet address to mint to
+//
+// metadata: metadata of the NFT to mint
+//
+// returns: the prepared mint transaction
+//
+// Example
+//
+//	tx, err := contract.PrepareMintTo(context.Background(), "{{wallet_address}}", metadata)
+//	estimate, err := tx.Estimate(context.Background())
+func (nft *NFTCollection) PrepareMintTo(ctx context.Context, address string, metadata *NFTMetadataInput) (*PreparedTx, error) {
+	uri, err := uploadOrExtractUri(ctx, metadata, nft.storage)
 	if err != nil {
 		return nil, err
 	}
 
-	return nft.Helper.AwaitTx(ctx, tx.Hash())
+	return newPreparedTx(nft.Helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return nft.abi.MintTo(
+			opts,
+			common.HexToAddress(address),
+			uri,
+		)
+	}), nil
 }
 
 // Mint a batch of new NFTs to the connected wallet.
@@ -178,11 +204,21 @@ func (nft *NFTCollection) MintTo(ctx context.Context, address string, metadata *
 // metadatas: list of metadata of the NFTs to mint
 //
 // returns: the transaction receipt of the mint
-func (nft *NFTCollection) MintBatch(ctx context.Context, metadatas []*NFTMetadataInput) (*types.Transaction, error) {
+func (nft *NFTCollection) MintBatch(ctx context.Context, metadatas []*NFTMetadataInput) (*TransactionResult, error) {
 	address := nft.Helper.GetSignerAddress().String()
 	return nft.MintBatchTo(ctx, address, metadatas)
 }
 
+// Prepare the mint of a batch of new NFTs to the connected wallet without sending it.
+//
+// metadatas: list of metadata of the NFTs to mint
+//
+// returns: the prepared mint transaction
+func (nft *NFTCollection) PrepareMintBatch(ctx context.Context, metadatas []*NFTMetadataInput) (*PreparedTx, error) {
+	address := nft.Helper.GetSignerAddress().String()
+	return nft.PrepareMintBatchTo(ctx, address, metadatas)
+}
+
 // Mint a batch of new NFTs to the specified wallet.
 //
 // to: the wallet address to mint to
@@ -205,7 +241,24 @@ func (nft *NFTCollection) MintBatch(ctx context.Context, metadatas []*NFTMetadat
 //	}
 //
 //	tx, err := contract.MintBatchTo(context.Background(), "{{wallet_address}}", metadatas)
-func (nft *NFTCollection) MintBatchTo(ctx context.Context, address string, metadatas []*NFTMetadataInput) (*types.Transaction, error) {
+func (nft *NFTCollection) MintBatchTo(ctx context.Context, address string, metadatas []*NFTMetadataInput) (*TransactionResult, error) {
+	tx, err := nft.PrepareMintBatchTo(ctx, address, metadatas)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the mint of a batch of new NFTs to the specified wallet without sending it. The
+// metadatas are uploaded when preparing the transaction.
+//
+// to: the wallet address to mint to
+//
+// metadatas: list of metadata of the NFTs to mint
+//
+// returns: the prepared mint transaction
+func (nft *NFTCollection) PrepareMintBatchTo(ctx context.Context, address string, metadatas []*NFTMetadataInput) (*PreparedTx, error) {
 	uris, err := uploadOrExtractUris(ctx, metadatas, nft.storage)
 	if err != nil {
 		return nil, err
@@ -213,30 +266,15 @@ func (nft *NFTCollection) MintBatchTo(ctx context.Context, address string, metad
 
 	encoded := [][]byte{}
 	for _, uri := range uris {
-		txOpts, err := nft.Helper.getEncodedTxOptions(ctx)
-		if err != nil {
-			return nil, err
-		}
-		tx, err := nft.abi.MintTo(
-			txOpts,
-			common.HexToAddress(address),
-			uri,
-		)
+		data, err := encodeCallData(abi.TokenERC721MetaData, "mintTo", common.HexToAddress(address), uri)
 		if err != nil {
 			return nil, err
 		}
 
-		encoded = append(encoded, tx.Data())
-	}
-
-	txOpts, err := nft.Helper.GetTxOptions(ctx)
-	if err != nil {
-		return nil, err
-	}
-	tx, err := nft.abi.Multicall(txOpts, encoded)
-	if err != nil {
-		return nil, err
+		encoded = append(encoded, data)
 	}
 
-	return nft.Helper.AwaitTx(ctx, tx.Hash())
+	return newPreparedTx(nft.Helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return nft.abi.Multicall(opts, encoded)
+	}), nil
 }
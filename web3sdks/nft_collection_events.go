@@ -0,0 +1,169 @@
+package web3sdks
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/web3sdks/go-sdk/v2/abi"
+)
+
+// The events of the NFT collection contract, decoded with the generated bindings of the contract into
+// typed events instead of the generic ContractEvent.
+//
+// All the methods of ContractEvents are available as well, like GetEvents or AddEventListener.
+//
+// Example
+//
+//	contract, err := sdk.GetNFTCollection("{{contract_address}}")
+//
+//	transfers, err := contract.Events.GetTransfers(context.Background(), web3sdks.EventQueryOptions{})
+//	fmt.Println(transfers[0].TokenId)
+type NFTCollectionEvents struct {
+	*ContractEvents
+	filterer *abi.TokenERC721Filterer
+}
+
+func newNFTCollectionEvents(contractAbi *abi.TokenERC721, helper *contractHelper) (*NFTCollectionEvents, error) {
+	events, err := newContractEvents(abi.TokenERC721ABI, helper)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NFTCollectionEvents{
+		ContractEvents: events,
+		filterer:       &contractAbi.TokenERC721Filterer,
+	}, nil
+}
+
+// Query the past NFT transfers, including the mints and burns.
+//
+// options: The options to use when querying for events, including block range specifications and
+// filters on the indexed arguments of the Transfer event
+//
+// returns: the Transfer events that match the query, in the order of the options
+//
+// Example
+//
+//	transfers, err := contract.Events.GetTransfers(context.Background(), web3sdks.EventQueryOptions{
+//	  FromBlock: 100000000,
+//	})
+//
+//	for _, transfer := range transfers {
+//	  fmt.Println(transfer.TokenId, transfer.Raw.BlockNumber)
+//	}
+func (events *NFTCollectionEvents) GetTransfers(ctx context.Context, options EventQueryOptions) ([]*abi.TokenERC721Transfer, error) {
+	transfers := []*abi.TokenERC721Transfer{}
+	err := events.queryLogs(ctx, "Transfer", options, func(log types.Log) error {
+		transfer, err := events.filterer.ParseTransfer(log)
+		if err != nil {
+			return err
+		}
+
+		transfers = append(transfers, transfer)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+// Listen in the background for the future NFT transfers, including the mints and burns, with the same options as AddEventListener.
+//
+// listener: The listener function that will be called with every new Transfer event. The events
+// removed by a reorg are delivered again with Raw.Removed set to true
+//
+// options: Optional options of the listener, like the poll interval or the block to start listening from
+//
+// returns: An EventSubscription object that can be used to unsubscribe from the event or check for errors
+//
+// Example
+//
+//	subscription := contract.Events.OnTransfer(context.Background(), func(transfer *abi.TokenERC721Transfer) {
+//	  fmt.Println(transfer.TokenId)
+//	})
+//
+//	subscription.Unsubscribe()
+func (events *NFTCollectionEvents) OnTransfer(
+	ctx context.Context,
+	listener func(event *abi.TokenERC721Transfer),
+	options ...*EventListenerOptions,
+) EventSubscription {
+	return events.listenLogs(ctx, "Transfer", func(log types.Log) error {
+		transfer, err := events.filterer.ParseTransfer(log)
+		if err != nil {
+			return err
+		}
+
+		listener(transfer)
+		return nil
+	}, options)
+}
+
+// Query the past NFTs minted.
+//
+// options: The options to use when querying for events, including block range specifications and
+// filters on the indexed arguments of the TokensMinted event
+//
+// returns: the TokensMinted events that match the query, in the order of the options
+//
+// Example
+//
+//	mints, err := contract.Events.GetTokensMinted(context.Background(), web3sdks.EventQueryOptions{
+//	  FromBlock: 100000000,
+//	})
+//
+//	for _, mint := range mints {
+//	  fmt.Println(mint.TokenIdMinted, mint.Raw.BlockNumber)
+//	}
+func (events *NFTCollectionEvents) GetTokensMinted(ctx context.Context, options EventQueryOptions) ([]*abi.TokenERC721TokensMinted, error) {
+	mints := []*abi.TokenERC721TokensMinted{}
+	err := events.queryLogs(ctx, "TokensMinted", options, func(log types.Log) error {
+		mint, err := events.filterer.ParseTokensMinted(log)
+		if err != nil {
+			return err
+		}
+
+		mints = append(mints, mint)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mints, nil
+}
+
+// Listen in the background for the future NFTs minted, with the same options as AddEventListener.
+//
+// listener: The listener function that will be called with every new TokensMinted event. The events
+// removed by a reorg are delivered again with Raw.Removed set to true
+//
+// options: Optional options of the listener, like the poll interval or the block to start listening from
+//
+// returns: An EventSubscription object that can be used to unsubscribe from the event or check for errors
+//
+// Example
+//
+//	subscription := contract.Events.OnTokensMinted(context.Background(), func(mint *abi.TokenERC721TokensMinted) {
+//	  fmt.Println(mint.TokenIdMinted)
+//	})
+//
+//	subscription.Unsubscribe()
+func (events *NFTCollectionEvents) OnTokensMinted(
+	ctx context.Context,
+	listener func(event *abi.TokenERC721TokensMinted),
+	options ...*EventListenerOptions,
+) EventSubscription {
+	return events.listenLogs(ctx, "TokensMinted", func(log types.Log) error {
+		mint, err := events.filterer.ParseTokensMinted(log)
+		if err != nil {
+			return err
+		}
+
+		listener(mint)
+		return nil
+	}, options)
+}
@@ -34,17 +34,17 @@ type NFTDrop struct {
 	Helper          *contractHelper
 	ClaimConditions *NFTDropClaimConditions
 	Encoder         *NFTDropEncoder
-	Events          *ContractEvents
+	Events          *NFTDropEvents
 }
 
-func newNFTDrop(provider *ethclient.Client, address common.Address, privateKey string, storage storage) (*NFTDrop, error) {
-	if contractAbi, err := abi.NewDropERC721(address, provider); err != nil {
+func newNFTDrop(provider *ethclient.Client, address common.Address, handler *ProviderHandler, storage storage) (*NFTDrop, error) {
+	if contractAbi, err := abi.NewDropERC721(address, handler.getContractBackend()); err != nil {
 		return nil, err
 	} else {
-		if helper, err := newContractHelper(address, provider, privateKey); err != nil {
+		if helper, err := newContractHelper(address, handler); err != nil {
 			return nil, err
 		} else {
-			if erc721, err := newERC721(provider, address, privateKey, storage); err != nil {
+			if erc721, err := newERC721(provider, address, handler, storage); err != nil {
 				return nil, err
 			} else {
 				claimConditions, err := newNFTDropClaimConditions(address, provider, helper, storage)
@@ -52,12 +52,7 @@ func newNFTDrop(provider *ethclient.Client, address common.Address, privateKey s
 					return nil, err
 				}
 
-				encoder, err := newNFTDropEncoder(contractAbi, helper, claimConditions, storage)
-				if err != nil {
-					return nil, err
-				}
-
-				events, err := newContractEvents(abi.DropERC721ABI, helper)
+				events, err := newNFTDropEvents(contractAbi, helper)
 				if err != nil {
 					return nil, err
 				}
@@ -67,9 +62,15 @@ func newNFTDrop(provider *ethclient.Client, address common.Address, privateKey s
 					contractAbi,
 					helper,
 					claimConditions,
-					encoder,
+					nil,
 					events,
 				}
+
+				nftCollection.Encoder, err = newNFTDropEncoder(nftCollection)
+				if err != nil {
+					return nil, err
+				}
+
 				return nftCollection, nil
 			}
 		}
@@ -80,7 +81,8 @@ func newNFTDrop(provider *ethclient.Client, address common.Address, privateKey s
 //
 // address: the address of the owner of the NFTs
 //
-// returns: the metadata of all the NFTs owned by the address
+// returns: the metadata of all the NFTs owned by the address. If some of the NFTs
+// can't be fetched, the others are returned along with a *PartialResultError
 //
 // Example
 //
@@ -114,12 +116,27 @@ func (nft *NFTDrop) GetOwnedTokenIDs(ctx context.Context, address string) ([]*bi
 		return nil, err
 	}
 
-	tokenIds := []*big.Int{}
+	contractAbi, err := abi.DropERC721MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	// read the owners of all tokens in as few requests as possible
+	reads := []*contractRead{}
 	for i := 0; i < int(totalCount.Int64()); i++ {
-		owner, err := nft.abi.OwnerOf(&bind.CallOpts{Context: ctx}, big.NewInt(int64(i)))
+		read, err := newContractRead(nft.helper.getAddress(), contractAbi, "ownerOf", big.NewInt(int64(i)))
 		if err != nil {
 			return nil, err
 		}
+		reads = append(reads, read)
+	}
+
+	tokenIds := []*big.Int{}
+	for i, result := range nft.helper.batchRead(ctx, reads) {
+		owner := common.Address{}
+		if err := result.unpackInto(&owner); err != nil {
+			return nil, err
+		}
 
 		if strings.ToLower(owner.String()) == strings.ToLower(address) {
 			tokenIds = append(tokenIds, big.NewInt(int64(i)))
@@ -131,37 +148,63 @@ func (nft *NFTDrop) GetOwnedTokenIDs(ctx context.Context, address string) ([]*bi
 
 // Get a list of all the NFTs that have been claimed from this contract.
 //
-// returns: a list of the metadatas of the claimed NFTs
+// params: optional page of the claimed NFTs to get, all of them are returned by default
+//
+// returns: a list of the metadatas of the claimed NFTs. If some of the NFTs can't be fetched,
+// the others are returned along with a *PartialResultError
 //
 // Example
 //
 //	claimedNfts, err := contract.GetAllClaimed(context.Background())
 //	firstOwner := claimedNfts[0].Owner
-func (drop *NFTDrop) GetAllClaimed(ctx context.Context) ([]*NFTMetadataOwner, error) {
+func (drop *NFTDrop) GetAllClaimed(ctx context.Context, params ...*QueryAllParams) ([]*NFTMetadataOwner, error) {
 	if maxId, err := drop.Abi.NextTokenIdToClaim(&bind.CallOpts{Context: ctx}); err != nil {
 		return nil, err
 	} else {
-		nfts := []*NFTMetadataOwner{}
-
-		for i := 0; i < int(maxId.Int64()); i++ {
-			if nft, err := drop.Get(ctx, i); err == nil {
-				nfts = append(nfts, nft)
-			}
+		tokenIds, err := getPageTokenIds(0, int(maxId.Int64()), params)
+		if err != nil {
+			return nil, err
 		}
+		return drop.fetchNFTsByTokenId(ctx, tokenIds)
+	}
+}
 
-		return nfts, nil
+// Iterate over all the NFTs that have been claimed from this contract, fetching them page by page
+// without holding the whole collection in memory.
+//
+// pageSize: the number of NFTs fetched by every call to Next, defaults to 100
+//
+// returns: the iterator over the NFTs claimed when it is created
+//
+// Example
+//
+//	iterator, err := contract.IterateClaimed(context.Background(), 500)
+//	for iterator.HasNext() {
+//		claimedNfts, err := iterator.Next(context.Background())
+//		...
+//	}
+func (drop *NFTDrop) IterateClaimed(ctx context.Context, pageSize int) (*NFTIterator, error) {
+	maxId, err := drop.Abi.NextTokenIdToClaim(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, err
 	}
+
+	return &NFTIterator{erc721: drop.ERC721, pages: newTokenIdPages(0, int(maxId.Int64()), pageSize)}, nil
 }
 
 // Get a list of all the NFTs on this contract that have not yet been claimed.
 //
-// returns: a list of the metadatas of the unclaimed NFTs
+// params: optional page of the unclaimed NFTs to get, starting from the first unclaimed NFT. All
+// of them are returned by default
+//
+// returns: a list of the metadatas of the unclaimed NFTs. If some of the NFTs can't be fetched,
+// the others are returned along with a *PartialResultError
 //
 // Example
 //
 //	unclaimedNfts, err := contract.GetAllUnclaimed(context.Background())
 //	firstNftName := unclaimedNfts[0].Name
-func (drop *NFTDrop) GetAllUnclaimed(ctx context.Context) ([]*NFTMetadata, error) {
+func (drop *NFTDrop) GetAllUnclaimed(ctx context.Context, params ...*QueryAllParams) ([]*NFTMetadata, error) {
 	maxId, err := drop.Abi.NextTokenIdToMint(&bind.CallOpts{Context: ctx})
 	if err != nil {
 		return nil, err
@@ -171,14 +214,40 @@ func (drop *NFTDrop) GetAllUnclaimed(ctx context.Context) ([]*NFTMetadata, error
 		return nil, err
 	}
 
-	nfts := []*NFTMetadata{}
-	for i := int(unmintedId.Int64()); i < int(maxId.Int64()); i++ {
-		if nft, err := drop.getTokenMetadata(ctx, i); err == nil {
-			nfts = append(nfts, nft)
-		}
+	tokenIds, err := getPageTokenIds(int(unmintedId.Int64()), int(maxId.Int64()), params)
+	if err != nil {
+		return nil, err
 	}
 
-	return nfts, nil
+	return drop.fetchNFTMetadatasByTokenId(ctx, tokenIds)
+}
+
+// Iterate over all the NFTs on this contract that have not yet been claimed, fetching them page by
+// page without holding the whole collection in memory.
+//
+// pageSize: the number of NFTs fetched by every call to Next, defaults to 100
+//
+// returns: the iterator over the NFTs unclaimed when it is created
+//
+// Example
+//
+//	iterator, err := contract.IterateUnclaimed(context.Background(), 500)
+//	for iterator.HasNext() {
+//		unclaimedNfts, err := iterator.Next(context.Background())
+//		...
+//	}
+func (drop *NFTDrop) IterateUnclaimed(ctx context.Context, pageSize int) (*NFTMetadataIterator, error) {
+	maxId, err := drop.Abi.NextTokenIdToMint(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, err
+	}
+	unmintedId, err := drop.Abi.NextTokenIdToClaim(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, err
+	}
+
+	pages := newTokenIdPages(int(unmintedId.Int64()), int(maxId.Int64()), pageSize)
+	return &NFTMetadataIterator{erc721: drop.ERC721, pages: pages}, nil
 }
 
 // Get the total number of NFTs that have been claimed.
@@ -226,12 +295,7 @@ func (drop *NFTDrop) GetTotalClaimed(ctx context.Context, address string) (*big.
 }
 
 func (drop *NFTDrop) GetClaimInfo(ctx context.Context, address string) (*ClaimInfo, error) {
-	claimVerification, err := drop.prepareClaim(ctx, address, 0, false)
-	if err != nil {
-		return nil, err
-	}
-
-	active, err := drop.ClaimConditions.GetActive(ctx)
+	claimVerification, active, err := drop.prepareClaim(ctx, address, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -300,11 +364,10 @@ func (drop *NFTDrop) GetClaimIneligibilityReasons(ctx context.Context, quantity
 		}
 
 		if allowlistEntry != nil {
-			claimVerification, err := drop.prepareClaim(
+			claimVerification, _, err := drop.prepareClaim(
 				ctx,
 				addressToCheck,
 				quantity,
-				false,
 			)
 			if err != nil {
 				return reasons, err
@@ -421,7 +484,22 @@ func (drop *NFTDrop) GetClaimIneligibilityReasons(ctx context.Context, quantity
 //	}
 //
 //	tx, err := contract.CreateBatch(context.Background(), metadatas)
-func (drop *NFTDrop) CreateBatch(ctx context.Context, metadatas []*NFTMetadataInput) (*types.Transaction, error) {
+func (drop *NFTDrop) CreateBatch(ctx context.Context, metadatas []*NFTMetadataInput) (*TransactionResult, error) {
+	tx, err := drop.PrepareCreateBatch(ctx, metadatas)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the lazy mint of a batch of NFTs without sending it. The metadatas are uploaded when
+// preparing the transaction.
+//
+// metadatas: list of NFT metadatas to create
+//
+// returns: the prepared transaction of the batch creation
+func (drop *NFTDrop) PrepareCreateBatch(ctx context.Context, metadatas []*NFTMetadataInput) (*PreparedTx, error) {
 	startNumber, err := drop.Abi.NextTokenIdToMint(&bind.CallOpts{Context: ctx})
 	if err != nil {
 		return nil, err
@@ -448,22 +526,18 @@ func (drop *NFTDrop) CreateBatch(ctx context.Context, metadatas []*NFTMetadataIn
 		contractAddress,
 		signerAddress,
 	)
-
-	txOpts, err := drop.Helper.GetTxOptions(ctx)
-	if err != nil {
-		return nil, err
-	}
-	tx, err := drop.Abi.LazyMint(
-		txOpts,
-		big.NewInt(int64(len(batch.uris))),
-		batch.baseUri,
-		[]byte{},
-	)
 	if err != nil {
 		return nil, err
 	}
 
-	return drop.Helper.AwaitTx(ctx, tx.Hash())
+	return newPreparedTx(drop.Helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return drop.Abi.LazyMint(
+			opts,
+			big.NewInt(int64(len(batch.uris))),
+			batch.baseUri,
+			[]byte{},
+		)
+	}), nil
 }
 
 // Claim NFTs from this contract to the connect wallet.
@@ -471,11 +545,21 @@ func (drop *NFTDrop) CreateBatch(ctx context.Context, metadatas []*NFTMetadataIn
 // quantity: the number of NFTs to claim
 //
 // returns: the transaction receipt of the claim
-func (drop *NFTDrop) Claim(ctx context.Context, quantity int) (*types.Transaction, error) {
+func (drop *NFTDrop) Claim(ctx context.Context, quantity int) (*TransactionResult, error) {
 	address := drop.Helper.GetSignerAddress().String()
 	return drop.ClaimTo(ctx, address, quantity)
 }
 
+// Prepare a claim of NFTs from this contract to the connected wallet without sending it.
+//
+// quantity: the number of NFTs to claim
+//
+// returns: the prepared claim transaction
+func (drop *NFTDrop) PrepareClaim(ctx context.Context, quantity int) (*PreparedTx, error) {
+	address := drop.Helper.GetSignerAddress().String()
+	return drop.PrepareClaimTo(ctx, address, quantity)
+}
+
 // Claim NFTs from this contract to the connect wallet.
 //
 // destinationAddress: the address of the wallet to claim the NFTs to
@@ -490,21 +574,59 @@ func (drop *NFTDrop) Claim(ctx context.Context, quantity int) (*types.Transactio
 //	quantity = 1
 //
 //	tx, err := contract.ClaimTo(context.Background(), address, quantity)
-func (drop *NFTDrop) ClaimTo(ctx context.Context, destinationAddress string, quantity int) (*types.Transaction, error) {
-	addressToClaim := drop.helper.GetSignerAddress().Hex()
-
-	claimVerification, err := drop.prepareClaim(ctx, addressToClaim, quantity, true)
+func (drop *NFTDrop) ClaimTo(ctx context.Context, destinationAddress string, quantity int) (*TransactionResult, error) {
+	tx, err := drop.PrepareClaimTo(ctx, destinationAddress, quantity)
 	if err != nil {
 		return nil, err
 	}
 
-	txOpts, err := drop.Helper.GetTxOptions(ctx)
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare a claim of NFTs from this contract to a specified wallet without sending it. If the
+// claim is paid in an ERC20 token, the allowance is approved when the transaction is sent.
+//
+// destinationAddress: the address of the wallet to claim the NFTs to
+//
+// quantity: the number of NFTs to claim
+//
+// returns: the prepared claim transaction
+//
+// Example
+//
+//	tx, err := contract.PrepareClaimTo(context.Background(), "{{wallet_address}}", 1)
+//	estimate, err := tx.Estimate(context.Background())
+func (drop *NFTDrop) PrepareClaimTo(ctx context.Context, destinationAddress string, quantity int) (*PreparedTx, error) {
+	return drop.prepareClaimTo(ctx, drop.Helper.GetSignerAddress().Hex(), destinationAddress, quantity)
+}
+
+func (drop *NFTDrop) prepareClaimTo(ctx context.Context, claimerAddress string, destinationAddress string, quantity int) (*PreparedTx, error) {
+	claimVerification, active, err := drop.prepareClaim(ctx, claimerAddress, quantity)
 	if err != nil {
 		return nil, err
 	}
 
-	txOpts.Value = claimVerification.Value
+	tx := drop.newClaimTx(claimerAddress, destinationAddress, quantity, claimVerification)
+
+	// Handle approval for ERC20
+	pricePerToken, currencyAddress := getClaimPayment(claimVerification, active)
+	if pricePerToken.Cmp(big.NewInt(0)) > 0 && !isNativeToken(currencyAddress) {
+		tx.beforeSend = func(ctx context.Context) error {
+			return approveErc20Allowance(
+				ctx,
+				drop.Helper,
+				currencyAddress,
+				pricePerToken,
+				quantity,
+			)
+		}
+	}
+
+	return tx, nil
+}
 
+// Build the claim transaction from the claim verification of the claimer
+func (drop *NFTDrop) newClaimTx(claimerAddress string, destinationAddress string, quantity int, claimVerification *ClaimVerification) *PreparedTx {
 	proof := abi.IDropAllowlistProof{
 		Proof:                  claimVerification.Proofs,
 		QuantityLimitPerWallet: claimVerification.MaxClaimable,
@@ -512,20 +634,21 @@ func (drop *NFTDrop) ClaimTo(ctx context.Context, destinationAddress string, qua
 		Currency:               common.HexToAddress(claimVerification.CurrencyAddressInProof),
 	}
 
-	tx, err := drop.Abi.Claim(
-		txOpts,
-		common.HexToAddress(destinationAddress),
-		big.NewInt(int64(quantity)),
-		common.HexToAddress(claimVerification.CurrencyAddress),
-		claimVerification.Price,
-		proof,
-		[]byte{},
-	)
-	if err != nil {
-		return nil, err
-	}
+	tx := newPreparedTx(drop.Helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		opts.Value = claimVerification.Value
+		return drop.Abi.Claim(
+			opts,
+			common.HexToAddress(destinationAddress),
+			big.NewInt(int64(quantity)),
+			common.HexToAddress(claimVerification.CurrencyAddress),
+			claimVerification.Price,
+			proof,
+			[]byte{},
+		)
+	})
+	tx.From = common.HexToAddress(claimerAddress)
 
-	return drop.Helper.AwaitTx(ctx, tx.Hash())
+	return tx
 }
 
 func (drop *NFTDrop) GetClaimArguments(
@@ -536,7 +659,7 @@ func (drop *NFTDrop) GetClaimArguments(
 	*ClaimArguments,
 	error,
 ) {
-	claimVerification, err := drop.prepareClaim(ctx, destinationAddress, quantity, false)
+	claimVerification, _, err := drop.prepareClaim(ctx, destinationAddress, quantity)
 	if err != nil {
 		return nil, err
 	}
@@ -559,15 +682,15 @@ func (drop *NFTDrop) GetClaimArguments(
 	}, nil
 }
 
-func (drop *NFTDrop) prepareClaim(ctx context.Context, addressToClaim string, quantity int, handleApproval bool) (*ClaimVerification, error) {
+func (drop *NFTDrop) prepareClaim(ctx context.Context, addressToClaim string, quantity int) (*ClaimVerification, *ClaimConditionOutput, error) {
 	active, err := drop.ClaimConditions.GetActive(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	merkleMetadata, err := drop.ClaimConditions.getMerkleMetadata(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	claimVerification, err := prepareClaim(
@@ -580,42 +703,30 @@ func (drop *NFTDrop) prepareClaim(ctx context.Context, addressToClaim string, qu
 		drop.storage,
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	// Handle approval for ERC20
-	if handleApproval {
-		MaxUint256 := new(big.Int).Sub(new(big.Int).Lsh(common.Big1, 256), common.Big1)
+	return claimVerification, active, nil
+}
 
-		var pricePerToken *big.Int
-		if claimVerification.Price.Cmp(MaxUint256) == 0 {
-			pricePerToken = active.Price
-		} else {
-			pricePerToken = claimVerification.Price
-		}
+// Get the price per token and currency actually paid for a claim, which come from the allowlist
+// entry of the claimer if there is one, and from the active claim condition otherwise
+func getClaimPayment(claimVerification *ClaimVerification, active *ClaimConditionOutput) (*big.Int, string) {
+	MaxUint256 := new(big.Int).Sub(new(big.Int).Lsh(common.Big1, 256), common.Big1)
 
-		var currencyAddress string
-		if claimVerification.CurrencyAddress != zeroAddress {
-			currencyAddress = claimVerification.CurrencyAddress
-		} else {
-			currencyAddress = active.CurrencyAddress
-		}
+	var pricePerToken *big.Int
+	if claimVerification.Price.Cmp(MaxUint256) == 0 {
+		pricePerToken = active.Price
+	} else {
+		pricePerToken = claimVerification.Price
+	}
 
-		if pricePerToken.Cmp(big.NewInt(0)) > 0 {
-			if !isNativeToken(currencyAddress) {
-				err := approveErc20Allowance(
-					ctx,
-					drop.Helper,
-					currencyAddress,
-					pricePerToken,
-					quantity,
-				)
-				if err != nil {
-					return nil, err
-				}
-			}
-		}
+	var currencyAddress string
+	if claimVerification.CurrencyAddress != zeroAddress {
+		currencyAddress = claimVerification.CurrencyAddress
+	} else {
+		currencyAddress = active.CurrencyAddress
 	}
 
-	return claimVerification, nil
+	return pricePerToken, currencyAddress
 }
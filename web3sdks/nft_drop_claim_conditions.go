@@ -23,7 +23,7 @@ type NFTDropClaimConditions struct {
 }
 
 func newNFTDropClaimConditions(address common.Address, provider *ethclient.Client, helper *contractHelper, storage storage) (*NFTDropClaimConditions, error) {
-	if contractAbi, err := abi.NewDropERC721(address, provider); err != nil {
+	if contractAbi, err := abi.NewDropERC721(address, helper.getContractBackend()); err != nil {
 		return nil, err
 	} else {
 		claimConditions := &NFTDropClaimConditions{
@@ -61,11 +61,11 @@ func (claim *NFTDropClaimConditions) GetActive(ctx context.Context) (*ClaimCondi
 		return nil, err
 	}
 
-	provider := claim.helper.GetProvider()
+	backend := claim.helper.getContractBackend()
 	claimCondition, err := transformResultToClaimCondition(
 		ctx,
 		&active,
-		provider,
+		backend,
 	)
 	if err != nil {
 		return nil, err
@@ -80,12 +80,12 @@ func (claim *NFTDropClaimConditions) Get(ctx context.Context, claimConditionId i
 		return nil, err
 	}
 
-	provider := claim.helper.GetProvider()
+	backend := claim.helper.getContractBackend()
 
 	claimCondition, err := transformResultToClaimCondition(
 		ctx,
 		&condition,
-		provider,
+		backend,
 	)
 	if err != nil {
 		return nil, err
@@ -116,7 +116,7 @@ func (claim *NFTDropClaimConditions) GetAll(ctx context.Context) ([]*ClaimCondit
 		return nil, err
 	}
 
-	provider := claim.helper.GetProvider()
+	backend := claim.helper.getContractBackend()
 	startId := condition.CurrentStartId.Int64()
 	count := condition.Count.Int64()
 
@@ -130,7 +130,7 @@ func (claim *NFTDropClaimConditions) GetAll(ctx context.Context) ([]*ClaimCondit
 		claimCondition, err := transformResultToClaimCondition(
 			ctx,
 			&mc,
-			provider,
+			backend,
 		)
 		if err != nil {
 			return nil, err
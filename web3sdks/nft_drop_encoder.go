@@ -34,29 +34,22 @@ import (
 //	// Now the encoder can be accessed from the contract
 //	contract.Encoder.ClaimTo(...)
 type NFTDropEncoder struct {
-	abi             *abi.DropERC721
-	helper          *contractHelper
-	storage         storage
-	claimConditions *NFTDropClaimConditions
+	abi    *abi.DropERC721
+	helper *contractHelper
+	drop   *NFTDrop
 	*ContractEncoder
 }
 
-func newNFTDropEncoder(
-	contractAbi *abi.DropERC721,
-	helper *contractHelper,
-	claimConditions *NFTDropClaimConditions,
-	storage storage,
-) (*NFTDropEncoder, error) {
-	encoder, err := newContractEncoder(abi.DropERC721ABI, helper)
+func newNFTDropEncoder(drop *NFTDrop) (*NFTDropEncoder, error) {
+	encoder, err := newContractEncoder(abi.DropERC721ABI, drop.Helper)
 	if err != nil {
 		return nil, err
 	}
 
 	return &NFTDropEncoder{
-		abi:             contractAbi,
-		helper:          helper,
-		claimConditions: claimConditions,
-		storage:         storage,
+		abi:             drop.Abi,
+		helper:          drop.Helper,
+		drop:            drop,
 		ContractEncoder: encoder,
 	}, nil
 }
@@ -85,17 +78,18 @@ func newNFTDropEncoder(
 //	fmt.Println(tx.Data()) // Ex: get the data field or the nonce field (others are available)
 //	fmt.Println(tx.Nonce())
 func (encoder *NFTDropEncoder) ApproveClaimTo(ctx context.Context, signerAddress string, quantity int) (*types.Transaction, error) {
-	claimVerification, err := encoder.prepareClaim(ctx, quantity)
+	claimVerification, active, err := encoder.drop.prepareClaim(ctx, signerAddress, quantity)
 	if err != nil {
 		return nil, err
 	}
 
+	pricePerToken, currencyAddress := getClaimPayment(claimVerification, active)
 	return setErc20AllowanceEncoder(
 		ctx,
 		encoder.helper,
 		signerAddress,
-		claimVerification.Value,
-		claimVerification.CurrencyAddress,
+		big.NewInt(0).Mul(pricePerToken, big.NewInt(int64(quantity))),
+		currencyAddress,
 	)
 }
 
@@ -124,40 +118,14 @@ func (encoder *NFTDropEncoder) ApproveClaimTo(ctx context.Context, signerAddress
 //	fmt.Println(tx.Data()) // Ex: get the data field or the nonce field (others are available)
 //	fmt.Println(tx.Nonce())
 func (encoder *NFTDropEncoder) ClaimTo(ctx context.Context, signerAddress string, destinationAddress string, quantity int) (*types.Transaction, error) {
-	active, err := encoder.claimConditions.GetActive(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	claimVerification, err := encoder.prepareClaim(ctx, quantity)
-	if err != nil {
-		return nil, err
-	}
-
-	txOpts, err := encoder.helper.getUnsignedTxOptions(ctx, signerAddress)
+	claimVerification, active, err := encoder.drop.prepareClaim(ctx, signerAddress, quantity)
 	if err != nil {
 		return nil, err
 	}
 
-	txOpts.Value = claimVerification.Value
-
 	// Check for ERC20 Approval
-	MaxUint256 := new(big.Int).Sub(new(big.Int).Lsh(common.Big1, 256), common.Big1)
-	var pricePerToken *big.Int
-	if claimVerification.Price.Cmp(MaxUint256) == 0 {
-		pricePerToken = active.Price
-	} else {
-		pricePerToken = claimVerification.Price
-	}
-
-	var currencyAddress string
-	if claimVerification.CurrencyAddress != zeroAddress {
-		currencyAddress = claimVerification.CurrencyAddress
-	} else {
-		currencyAddress = active.CurrencyAddress
-	}
-
-	totalPrice := pricePerToken.Mul(big.NewInt(int64(quantity)), pricePerToken)
+	pricePerToken, currencyAddress := getClaimPayment(claimVerification, active)
+	totalPrice := big.NewInt(0).Mul(pricePerToken, big.NewInt(int64(quantity)))
 	if err := encoder.checkErc20Allowance(
 		ctx,
 		signerAddress,
@@ -167,50 +135,8 @@ func (encoder *NFTDropEncoder) ClaimTo(ctx context.Context, signerAddress string
 		return nil, err
 	}
 
-	proof := abi.IDropAllowlistProof{
-		Proof:                  claimVerification.Proofs,
-		QuantityLimitPerWallet: claimVerification.MaxClaimable,
-		PricePerToken:          claimVerification.Price,
-		Currency:               common.HexToAddress(claimVerification.CurrencyAddress),
-	}
-
-	return encoder.abi.Claim(
-		txOpts,
-		common.HexToAddress(destinationAddress),
-		big.NewInt(int64(quantity)),
-		common.HexToAddress(active.CurrencyAddress),
-		active.Price,
-		proof,
-		[]byte{},
-	)
-}
-
-func (encoder *NFTDropEncoder) prepareClaim(ctx context.Context, quantity int) (*ClaimVerification, error) {
-	active, err := encoder.claimConditions.GetActive(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	merkleMetadata, err := encoder.claimConditions.getMerkleMetadata(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	address := encoder.helper.getAddress().String()
-	claimVerification, err := prepareClaim(
-		ctx,
-		address,
-		quantity,
-		active,
-		merkleMetadata,
-		encoder.helper,
-		encoder.storage,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return claimVerification, nil
+	tx := encoder.drop.newClaimTx(signerAddress, destinationAddress, quantity, claimVerification)
+	return tx.Encode(ctx)
 }
 
 func (encoder *NFTDropEncoder) checkErc20Allowance(
@@ -0,0 +1,235 @@
+package web3sdks
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/web3sdks/go-sdk/v2/abi"
+)
+
+// The events of the NFT drop contract, decoded with the generated bindings of the contract into
+// typed events instead of the generic ContractEvent.
+//
+// All the methods of ContractEvents are available as well, like GetEvents or AddEventListener.
+//
+// Example
+//
+//	contract, err := sdk.GetNFTDrop("{{contract_address}}")
+//
+//	transfers, err := contract.Events.GetTransfers(context.Background(), web3sdks.EventQueryOptions{})
+//	fmt.Println(transfers[0].TokenId)
+type NFTDropEvents struct {
+	*ContractEvents
+	filterer *abi.DropERC721Filterer
+}
+
+func newNFTDropEvents(contractAbi *abi.DropERC721, helper *contractHelper) (*NFTDropEvents, error) {
+	events, err := newContractEvents(abi.DropERC721ABI, helper)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NFTDropEvents{
+		ContractEvents: events,
+		filterer:       &contractAbi.DropERC721Filterer,
+	}, nil
+}
+
+// Query the past NFT transfers, including the claims and burns.
+//
+// options: The options to use when querying for events, including block range specifications and
+// filters on the indexed arguments of the Transfer event
+//
+// returns: the Transfer events that match the query, in the order of the options
+//
+// Example
+//
+//	transfers, err := contract.Events.GetTransfers(context.Background(), web3sdks.EventQueryOptions{
+//	  FromBlock: 100000000,
+//	})
+//
+//	for _, transfer := range transfers {
+//	  fmt.Println(transfer.TokenId, transfer.Raw.BlockNumber)
+//	}
+func (events *NFTDropEvents) GetTransfers(ctx context.Context, options EventQueryOptions) ([]*abi.DropERC721Transfer, error) {
+	transfers := []*abi.DropERC721Transfer{}
+	err := events.queryLogs(ctx, "Transfer", options, func(log types.Log) error {
+		transfer, err := events.filterer.ParseTransfer(log)
+		if err != nil {
+			return err
+		}
+
+		transfers = append(transfers, transfer)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+// Listen in the background for the future NFT transfers, including the claims and burns, with the same options as AddEventListener.
+//
+// listener: The listener function that will be called with every new Transfer event. The events
+// removed by a reorg are delivered again with Raw.Removed set to true
+//
+// options: Optional options of the listener, like the poll interval or the block to start listening from
+//
+// returns: An EventSubscription object that can be used to unsubscribe from the event or check for errors
+//
+// Example
+//
+//	subscription := contract.Events.OnTransfer(context.Background(), func(transfer *abi.DropERC721Transfer) {
+//	  fmt.Println(transfer.TokenId)
+//	})
+//
+//	subscription.Unsubscribe()
+func (events *NFTDropEvents) OnTransfer(
+	ctx context.Context,
+	listener func(event *abi.DropERC721Transfer),
+	options ...*EventListenerOptions,
+) EventSubscription {
+	return events.listenLogs(ctx, "Transfer", func(log types.Log) error {
+		transfer, err := events.filterer.ParseTransfer(log)
+		if err != nil {
+			return err
+		}
+
+		listener(transfer)
+		return nil
+	}, options)
+}
+
+// Query the past NFT claims.
+//
+// options: The options to use when querying for events, including block range specifications and
+// filters on the indexed arguments of the TokensClaimed event
+//
+// returns: the TokensClaimed events that match the query, in the order of the options
+//
+// Example
+//
+//	claims, err := contract.Events.GetTokensClaimed(context.Background(), web3sdks.EventQueryOptions{
+//	  FromBlock: 100000000,
+//	})
+//
+//	for _, claim := range claims {
+//	  fmt.Println(claim.QuantityClaimed, claim.Raw.BlockNumber)
+//	}
+func (events *NFTDropEvents) GetTokensClaimed(ctx context.Context, options EventQueryOptions) ([]*abi.DropERC721TokensClaimed, error) {
+	claims := []*abi.DropERC721TokensClaimed{}
+	err := events.queryLogs(ctx, "TokensClaimed", options, func(log types.Log) error {
+		claim, err := events.filterer.ParseTokensClaimed(log)
+		if err != nil {
+			return err
+		}
+
+		claims = append(claims, claim)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// Listen in the background for the future NFT claims, with the same options as AddEventListener.
+//
+// listener: The listener function that will be called with every new TokensClaimed event. The events
+// removed by a reorg are delivered again with Raw.Removed set to true
+//
+// options: Optional options of the listener, like the poll interval or the block to start listening from
+//
+// returns: An EventSubscription object that can be used to unsubscribe from the event or check for errors
+//
+// Example
+//
+//	subscription := contract.Events.OnTokensClaimed(context.Background(), func(claim *abi.DropERC721TokensClaimed) {
+//	  fmt.Println(claim.QuantityClaimed)
+//	})
+//
+//	subscription.Unsubscribe()
+func (events *NFTDropEvents) OnTokensClaimed(
+	ctx context.Context,
+	listener func(event *abi.DropERC721TokensClaimed),
+	options ...*EventListenerOptions,
+) EventSubscription {
+	return events.listenLogs(ctx, "TokensClaimed", func(log types.Log) error {
+		claim, err := events.filterer.ParseTokensClaimed(log)
+		if err != nil {
+			return err
+		}
+
+		listener(claim)
+		return nil
+	}, options)
+}
+
+// Query the past batches of NFTs lazy minted.
+//
+// options: The options to use when querying for events, including block range specifications and
+// filters on the indexed arguments of the TokensLazyMinted event
+//
+// returns: the TokensLazyMinted events that match the query, in the order of the options
+//
+// Example
+//
+//	batches, err := contract.Events.GetTokensLazyMinted(context.Background(), web3sdks.EventQueryOptions{
+//	  FromBlock: 100000000,
+//	})
+//
+//	for _, batch := range batches {
+//	  fmt.Println(batch.EndTokenId, batch.Raw.BlockNumber)
+//	}
+func (events *NFTDropEvents) GetTokensLazyMinted(ctx context.Context, options EventQueryOptions) ([]*abi.DropERC721TokensLazyMinted, error) {
+	batches := []*abi.DropERC721TokensLazyMinted{}
+	err := events.queryLogs(ctx, "TokensLazyMinted", options, func(log types.Log) error {
+		batch, err := events.filterer.ParseTokensLazyMinted(log)
+		if err != nil {
+			return err
+		}
+
+		batches = append(batches, batch)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return batches, nil
+}
+
+// Listen in the background for the future batches of NFTs lazy minted, with the same options as AddEventListener.
+//
+// listener: The listener function that will be called with every new TokensLazyMinted event. The events
+// removed by a reorg are delivered again with Raw.Removed set to true
+//
+// options: Optional options of the listener, like the poll interval or the block to start listening from
+//
+// returns: An EventSubscription object that can be used to unsubscribe from the event or check for errors
+//
+// Example
+//
+//	subscription := contract.Events.OnTokensLazyMinted(context.Background(), func(batch *abi.DropERC721TokensLazyMinted) {
+//	  fmt.Println(batch.EndTokenId)
+//	})
+//
+//	subscription.Unsubscribe()
+func (events *NFTDropEvents) OnTokensLazyMinted(
+	ctx context.Context,
+	listener func(event *abi.DropERC721TokensLazyMinted),
+	options ...*EventListenerOptions,
+) EventSubscription {
+	return events.listenLogs(ctx, "TokensLazyMinted", func(log types.Log) error {
+		batch, err := events.filterer.ParseTokensLazyMinted(log)
+		if err != nil {
+			return err
+		}
+
+		listener(batch)
+		return nil
+	}, options)
+}
@@ -0,0 +1,218 @@
+package web3sdks
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Hands out sequential nonces to transactions sent concurrently from the same wallet. It is owned
+// by the ProviderHandler of the SDK, so every contract wrapper of an SDK shares it.
+//
+// Every time a nonce is handed out, the manager syncs with the pending nonce of the node, so that
+// transactions sent from outside the SDK don't leave it behind. The pending nonce of the node can
+// lag behind the transactions it accepted, like behind a load balancer or a failover transport, so
+// it only ever moves the next nonce of the manager forward. A nonce stays reserved from the time it
+// is handed out until the pending nonce of the node passes it, and is only handed out again if its
+// transaction failed to be signed or sent, or if the node dropped its transaction after accepting
+// it. Since a dropped transaction blocks every later nonce of the account, the manager checks that
+// the node still knows about the transaction of every nonce it hasn't passed once in a while, and
+// hands out the nonce again if it doesn't. A dropped nonce can also be filled right away with
+// CancelNonce.
+type nonceManager struct {
+	mu       sync.Mutex
+	accounts map[common.Address]*accountNonces
+	// How long the transaction of a nonce can go unchecked before checking it wasn't dropped
+	dropTimeout time.Duration
+}
+
+type accountNonces struct {
+	// The next nonce that has never been handed out, which never goes down
+	next uint64
+	// Nonces handed out and not yet passed by the pending nonce of the node
+	reserved map[uint64]*nonceReservation
+	// Nonces whose transaction failed to be signed or sent or was dropped by the node, which are
+	// handed out again first
+	free map[uint64]bool
+}
+
+type nonceReservation struct {
+	// Hash of the last transaction with the nonce accepted by the node, nil until one is accepted
+	hash *common.Hash
+	// When the node was last known to have the transaction
+	checkedAt time.Time
+}
+
+const defaultNonceDropTimeout = time.Minute * 5
+
+func newNonceManager() *nonceManager {
+	return &nonceManager{
+		accounts:    map[common.Address]*accountNonces{},
+		dropTimeout: defaultNonceDropTimeout,
+	}
+}
+
+// Get the next nonce to use for a transaction from the account. Every nonce acquired must be
+// either marked as sent once the node accepts its transaction, or released if its transaction
+// failed to be signed or sent.
+func (manager *nonceManager) acquire(ctx context.Context, handler *ProviderHandler, account common.Address) (uint64, error) {
+	// Holding the lock while syncing makes sure concurrent callers see each other's nonces
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	pending, err := handler.GetProvider().PendingNonceAt(ctx, account)
+	if err != nil {
+		return 0, err
+	}
+
+	nonces := manager.getAccount(account)
+	if pending > nonces.next {
+		nonces.next = pending
+	}
+	for nonce := range nonces.reserved {
+		if nonce < pending {
+			delete(nonces.reserved, nonce)
+		}
+	}
+	for nonce := range nonces.free {
+		if nonce < pending {
+			delete(nonces.free, nonce)
+		}
+	}
+	manager.freeDroppedNonces(ctx, handler, nonces)
+
+	// Reuse the lowest nonce whose transaction failed or was dropped before using new nonces
+	nonce := nonces.next
+	for free := range nonces.free {
+		if free < nonce {
+			nonce = free
+		}
+	}
+	if nonce == nonces.next {
+		nonces.next += 1
+	} else {
+		delete(nonces.free, nonce)
+	}
+	nonces.reserved[nonce] = &nonceReservation{}
+
+	return nonce, nil
+}
+
+// Free the nonces whose transaction wasn't checked for longer than the drop timeout and isn't known
+// by the node anymore. The nonces whose transaction can't be checked stay reserved, since the node
+// might just be unreachable.
+func (manager *nonceManager) freeDroppedNonces(ctx context.Context, handler *ProviderHandler, nonces *accountNonces) {
+	for nonce, reservation := range nonces.reserved {
+		if reservation.hash == nil || time.Since(reservation.checkedAt) < manager.dropTimeout {
+			continue
+		}
+
+		_, _, err := handler.GetProvider().TransactionByHash(ctx, *reservation.hash)
+		if errors.Is(err, ethereum.NotFound) {
+			delete(nonces.reserved, nonce)
+			nonces.free[nonce] = true
+		} else if err == nil {
+			reservation.checkedAt = time.Now()
+		}
+	}
+}
+
+// Mark a nonce as used by a transaction accepted by the node, so that it isn't handed out again
+// even if the pending nonce of the node lags behind it. A nonce freed after its transaction was
+// dropped is reserved again, since its transaction was replaced.
+func (manager *nonceManager) markSent(account common.Address, nonce uint64, hash common.Hash) {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	nonces := manager.getAccount(account)
+	if _, ok := nonces.reserved[nonce]; ok || nonces.free[nonce] {
+		delete(nonces.free, nonce)
+		nonces.reserved[nonce] = &nonceReservation{hash: &hash, checkedAt: time.Now()}
+	}
+}
+
+// Get the hash of the last transaction with the nonce accepted by the node, if the nonce is still
+// reserved.
+func (manager *nonceManager) getSentHash(account common.Address, nonce uint64) (common.Hash, bool) {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	nonces := manager.getAccount(account)
+	if reservation, ok := nonces.reserved[nonce]; ok && reservation.hash != nil {
+		return *reservation.hash, true
+	}
+
+	return common.Hash{}, false
+}
+
+// Release a nonce whose transaction failed to be signed or sent, so that it's handed out again by
+// the next acquire. The nonces of transactions already accepted by the node, like the nonce of a
+// transaction whose replacement failed to be sent, are never released.
+func (manager *nonceManager) release(account common.Address, nonce uint64) {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	nonces := manager.getAccount(account)
+	if reservation, ok := nonces.reserved[nonce]; ok && reservation.hash == nil {
+		delete(nonces.reserved, nonce)
+		nonces.free[nonce] = true
+	}
+}
+
+func (manager *nonceManager) getAccount(account common.Address) *accountNonces {
+	nonces, ok := manager.accounts[account]
+	if !ok {
+		nonces = &accountNonces{reserved: map[uint64]*nonceReservation{}, free: map[uint64]bool{}}
+		manager.accounts[account] = nonces
+	}
+
+	return nonces
+}
+
+// Copy a transaction with a different nonce
+func withNonce(tx *types.Transaction, nonce uint64) *types.Transaction {
+	switch tx.Type() {
+	case types.DynamicFeeTxType:
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:    tx.ChainId(),
+			Nonce:      nonce,
+			GasTipCap:  tx.GasTipCap(),
+			GasFeeCap:  tx.GasFeeCap(),
+			Gas:        tx.Gas(),
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: tx.AccessList(),
+		})
+	case types.AccessListTxType:
+		return types.NewTx(&types.AccessListTx{
+			ChainID:    tx.ChainId(),
+			Nonce:      nonce,
+			GasPrice:   tx.GasPrice(),
+			Gas:        tx.Gas(),
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: tx.AccessList(),
+		})
+	default:
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    nonce,
+			GasPrice: tx.GasPrice(),
+			Gas:      tx.Gas(),
+			To:       tx.To(),
+			Value:    tx.Value(),
+			Data:     tx.Data(),
+		})
+	}
+}
+
+// Get the sender of a signed transaction
+func getTxSender(tx *types.Transaction) (common.Address, error) {
+	return types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+}
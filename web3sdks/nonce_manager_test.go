@@ -0,0 +1,232 @@
+package web3sdks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/web3sdks/go-sdk/v2/abi"
+)
+
+// Mock node that keeps track of the nonces it received, and fails to send the transactions
+// for which failSend returns true
+func newNonceMockRpcServer(failSend func(nonce uint64) bool) (*mockRpcServer, func() []uint64) {
+	server := newFeeMockRpcServer(100)
+
+	var mu sync.Mutex
+	received := map[uint64]bool{}
+	server.handle("eth_getCode", func(params []json.RawMessage) (interface{}, error) {
+		return "0x01", nil
+	})
+	server.handle("eth_estimateGas", func(params []json.RawMessage) (interface{}, error) {
+		return hexutil.Uint64(50000), nil
+	})
+	server.handle("eth_getTransactionCount", func(params []json.RawMessage) (interface{}, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		// The pending nonce is the first nonce missing from the pool
+		pending := uint64(0)
+		for received[pending] {
+			pending += 1
+		}
+		return hexutil.Uint64(pending), nil
+	})
+	server.handle("eth_sendRawTransaction", func(params []json.RawMessage) (interface{}, error) {
+		var rawTx hexutil.Bytes
+		json.Unmarshal(params[0], &rawTx)
+		tx := new(types.Transaction)
+		tx.UnmarshalBinary(rawTx)
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if received[tx.Nonce()] {
+			return nil, errors.New("nonce too low")
+		}
+		if failSend != nil && failSend(tx.Nonce()) {
+			return nil, errors.New("insufficient funds for gas * price + value")
+		}
+
+		received[tx.Nonce()] = true
+		return tx.Hash(), nil
+	})
+
+	nonces := func() []uint64 {
+		mu.Lock()
+		defer mu.Unlock()
+
+		sent := []uint64{}
+		for nonce := range received {
+			sent = append(sent, nonce)
+		}
+		sort.Slice(sent, func(i, j int) bool { return sent[i] < sent[j] })
+		return sent
+	}
+
+	return server, nonces
+}
+
+func sendMockApproval(helper *contractHelper) error {
+	erc20, err := abi.NewIERC20(common.HexToAddress(secondaryWallet), helper.getContractBackend())
+	if err != nil {
+		return err
+	}
+
+	txOpts, err := helper.GetTxOptions(context.Background())
+	if err != nil {
+		return err
+	}
+
+	_, err = erc20.Approve(txOpts, common.HexToAddress(tertiaryWallet), big.NewInt(1))
+	return err
+}
+
+func TestNonceManagerConcurrentSends(t *testing.T) {
+	server, sentNonces := newNonceMockRpcServer(nil)
+	defer server.Close()
+
+	helper := server.helper(t)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Nil(t, sendMockApproval(helper))
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, []uint64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, sentNonces())
+}
+
+func TestNonceManagerReusesFailedNonce(t *testing.T) {
+	failed := false
+	server, sentNonces := newNonceMockRpcServer(func(nonce uint64) bool {
+		// Fail the first attempt to send nonce 1
+		if nonce == 1 && !failed {
+			failed = true
+			return true
+		}
+		return false
+	})
+	defer server.Close()
+
+	helper := server.helper(t)
+
+	assert.Nil(t, sendMockApproval(helper))
+	assert.NotNil(t, sendMockApproval(helper))
+	assert.Nil(t, sendMockApproval(helper))
+	assert.Nil(t, sendMockApproval(helper))
+
+	assert.Equal(t, []uint64{0, 1, 2}, sentNonces())
+}
+
+func TestNonceManagerReservesNonces(t *testing.T) {
+	server := newMockRpcServer()
+	defer server.Close()
+
+	pending := uint64(5)
+	server.handle("eth_getTransactionCount", func(params []json.RawMessage) (interface{}, error) {
+		return hexutil.Uint64(pending), nil
+	})
+
+	handler := server.helper(t).ProviderHandler
+	account := common.HexToAddress(adminWallet)
+	acquire := func() uint64 {
+		nonce, err := handler.nonces.acquire(context.Background(), handler, account)
+		assert.Nil(t, err)
+		return nonce
+	}
+
+	// Nonces being sent concurrently are never handed out twice
+	assert.Equal(t, uint64(5), acquire())
+	assert.Equal(t, uint64(6), acquire())
+	assert.Equal(t, uint64(7), acquire())
+
+	// Nonce 6 failed to send, so it's handed out again
+	handler.nonces.release(account, 6)
+	assert.Equal(t, uint64(6), acquire())
+
+	// Accepted nonces are never handed out again, even if a replacement fails to send
+	handler.nonces.markSent(account, 5, common.HexToHash("0x05"))
+	handler.nonces.markSent(account, 6, common.HexToHash("0x06"))
+	handler.nonces.markSent(account, 7, common.HexToHash("0x07"))
+	handler.nonces.release(account, 6)
+	assert.Equal(t, uint64(8), acquire())
+
+	// Transactions were sent from outside the SDK
+	pending = 20
+	assert.Equal(t, uint64(20), acquire())
+}
+
+func TestNonceManagerFreesDroppedNonces(t *testing.T) {
+	server := newMockRpcServer()
+	defer server.Close()
+
+	dropped := common.HexToHash("0x05")
+	known := newMockSignedTx(t)
+	server.handle("eth_getTransactionCount", func(params []json.RawMessage) (interface{}, error) {
+		return hexutil.Uint64(5), nil
+	})
+	server.handle("eth_getTransactionByHash", func(params []json.RawMessage) (interface{}, error) {
+		var hash common.Hash
+		json.Unmarshal(params[0], &hash)
+		if hash == dropped {
+			return nil, nil
+		}
+		return mockPendingTxJson(known), nil
+	})
+
+	handler := server.helper(t).ProviderHandler
+	account := common.HexToAddress(adminWallet)
+	acquire := func() uint64 {
+		nonce, err := handler.nonces.acquire(context.Background(), handler, account)
+		assert.Nil(t, err)
+		return nonce
+	}
+
+	assert.Equal(t, uint64(5), acquire())
+	assert.Equal(t, uint64(6), acquire())
+	handler.nonces.markSent(account, 5, dropped)
+	handler.nonces.markSent(account, 6, known.Hash())
+
+	// Transactions are only checked once they went unchecked for the drop timeout
+	assert.Equal(t, uint64(7), acquire())
+	assert.Equal(t, 0, server.callCount("eth_getTransactionByHash"))
+
+	// The node dropped the transaction of nonce 5, which blocks the later nonces until it's reused
+	handler.nonces.dropTimeout = 0
+	assert.Equal(t, uint64(5), acquire())
+	assert.Equal(t, uint64(8), acquire())
+
+	// The nonce stays reserved once its new transaction is accepted
+	handler.nonces.markSent(account, 5, common.HexToHash("0x55"))
+	assert.Equal(t, uint64(9), acquire())
+}
+
+func TestNonceManagerIgnoresLaggingPendingNonce(t *testing.T) {
+	server, sentNonces := newNonceMockRpcServer(nil)
+	defer server.Close()
+
+	// The node behind the load balancer never sees the accepted transactions
+	server.handle("eth_getTransactionCount", func(params []json.RawMessage) (interface{}, error) {
+		return hexutil.Uint64(0), nil
+	})
+
+	helper := server.helper(t)
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, sendMockApproval(helper))
+	}
+
+	assert.Equal(t, []uint64{0, 1, 2}, sentNonces())
+}
@@ -0,0 +1,132 @@
+package web3sdks
+
+import (
+	"context"
+	"errors"
+	"math/big"
+)
+
+const defaultPageSize = 100
+
+// Get the token ids of the page of the tokens from the first id to the end id
+func getPageTokenIds(firstId int, endId int, params []*QueryAllParams) ([]*big.Int, error) {
+	start := firstId
+	end := endId
+
+	if len(params) > 0 && params[0] != nil {
+		page := params[0]
+		if page.Start < 0 || page.Count < 0 {
+			return nil, errors.New("Start and count of the query params can't be negative")
+		}
+
+		start = firstId + page.Start
+		if page.Count > 0 && start+page.Count < end {
+			end = start + page.Count
+		}
+	}
+
+	tokenIds := []*big.Int{}
+	for i := start; i < end; i++ {
+		tokenIds = append(tokenIds, big.NewInt(int64(i)))
+	}
+
+	return tokenIds, nil
+}
+
+// Pages of consecutive token ids from the first id to the end id
+type tokenIdPages struct {
+	nextId   int
+	endId    int
+	pageSize int
+}
+
+func newTokenIdPages(firstId int, endId int, pageSize int) *tokenIdPages {
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	return &tokenIdPages{nextId: firstId, endId: endId, pageSize: pageSize}
+}
+
+func (pages *tokenIdPages) hasNext() bool {
+	return pages.nextId < pages.endId
+}
+
+func (pages *tokenIdPages) next() []*big.Int {
+	tokenIds, _ := getPageTokenIds(pages.nextId, pages.endId, []*QueryAllParams{{Count: pages.pageSize}})
+	pages.nextId += len(tokenIds)
+	return tokenIds
+}
+
+// Iterator over the NFTs of an ERC721 contract, fetching them page by page.
+type NFTIterator struct {
+	erc721 *ERC721
+	pages  *tokenIdPages
+}
+
+// Check whether there are NFTs left to fetch.
+func (iterator *NFTIterator) HasNext() bool {
+	return iterator.pages.hasNext()
+}
+
+// Fetch the next page of NFTs.
+//
+// returns: the metadata and owners of the NFTs of the page, or an empty list once all the NFTs
+// were fetched. If some of the NFTs can't be fetched, the others are returned along with a
+// *PartialResultError and the iteration can go on
+func (iterator *NFTIterator) Next(ctx context.Context) ([]*NFTMetadataOwner, error) {
+	if !iterator.pages.hasNext() {
+		return []*NFTMetadataOwner{}, nil
+	}
+
+	return iterator.erc721.fetchNFTsByTokenId(ctx, iterator.pages.next())
+}
+
+// Iterator over the NFTs of an ERC721 contract without their owners, like the unclaimed NFTs of a
+// drop, fetching them page by page.
+type NFTMetadataIterator struct {
+	erc721 *ERC721
+	pages  *tokenIdPages
+}
+
+// Check whether there are NFTs left to fetch.
+func (iterator *NFTMetadataIterator) HasNext() bool {
+	return iterator.pages.hasNext()
+}
+
+// Fetch the next page of NFTs.
+//
+// returns: the metadata of the NFTs of the page, or an empty list once all the NFTs were fetched.
+// If some of the NFTs can't be fetched, the others are returned along with a *PartialResultError
+// and the iteration can go on
+func (iterator *NFTMetadataIterator) Next(ctx context.Context) ([]*NFTMetadata, error) {
+	if !iterator.pages.hasNext() {
+		return []*NFTMetadata{}, nil
+	}
+
+	return iterator.erc721.fetchNFTMetadatasByTokenId(ctx, iterator.pages.next())
+}
+
+// Iterator over the NFTs of an ERC1155 contract, fetching them page by page.
+type EditionIterator struct {
+	erc1155 *ERC1155
+	pages   *tokenIdPages
+}
+
+// Check whether there are NFTs left to fetch.
+func (iterator *EditionIterator) HasNext() bool {
+	return iterator.pages.hasNext()
+}
+
+// Fetch the next page of NFTs.
+//
+// returns: the metadata and supplies of the NFTs of the page, or an empty list once all the NFTs
+// were fetched. If some of the NFTs can't be fetched, the others are returned along with a
+// *PartialResultError and the iteration can go on
+func (iterator *EditionIterator) Next(ctx context.Context) ([]*EditionMetadata, error) {
+	if !iterator.pages.hasNext() {
+		return []*EditionMetadata{}, nil
+	}
+
+	return fetchEditionsByTokenId(ctx, iterator.erc1155, iterator.pages.next())
+}
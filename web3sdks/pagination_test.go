@@ -0,0 +1,112 @@
+package web3sdks
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestPaginationDrop(t *testing.T) (*mockRpcServer, *mockGateway, *NFTDrop) {
+	gateway := newMockGateway(func(w http.ResponseWriter, tokenId string) {
+		w.Write([]byte(`{"name":"NFT ` + tokenId + `"}`))
+	})
+
+	server, erc721 := newTestWorkerPoolERC721(t, gateway.URL)
+	drop, err := newNFTDrop(erc721.helper.GetProvider(), erc721.helper.getAddress(), erc721.helper.ProviderHandler, erc721.storage)
+	assert.Nil(t, err)
+
+	return server, gateway, drop
+}
+
+func TestPaginationGetAll(t *testing.T) {
+	server, gateway, drop := newTestPaginationDrop(t)
+	defer server.Close()
+	defer gateway.Close()
+
+	nfts, err := drop.GetAll(context.Background(), &QueryAllParams{Start: 10, Count: 5})
+	assert.Nil(t, err)
+	assert.Len(t, nfts, 5)
+	assert.Equal(t, "NFT 10", nfts[0].Metadata.Name)
+	assert.Equal(t, "NFT 14", nfts[4].Metadata.Name)
+	assert.Equal(t, 5, gateway.hitCount())
+
+	// The last page is cut at the end of the collection
+	nfts, err = drop.GetAll(context.Background(), &QueryAllParams{Start: 45, Count: 10})
+	assert.Nil(t, err)
+	assert.Len(t, nfts, 5)
+
+	nfts, err = drop.GetAll(context.Background(), &QueryAllParams{Start: 60})
+	assert.Nil(t, err)
+	assert.Len(t, nfts, 0)
+
+	_, err = drop.GetAll(context.Background(), &QueryAllParams{Count: -1})
+	assert.NotNil(t, err)
+}
+
+func TestPaginationClaimedAndUnclaimed(t *testing.T) {
+	server, gateway, drop := newTestPaginationDrop(t)
+	defer server.Close()
+	defer gateway.Close()
+
+	claimed, err := drop.GetAllClaimed(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, claimed, 25)
+
+	claimed, err = drop.GetAllClaimed(context.Background(), &QueryAllParams{Start: 20, Count: 10})
+	assert.Nil(t, err)
+	assert.Len(t, claimed, 5)
+
+	// The start is relative to the first unclaimed NFT
+	unclaimed, err := drop.GetAllUnclaimed(context.Background(), &QueryAllParams{Start: 5, Count: 3})
+	assert.Nil(t, err)
+	assert.Len(t, unclaimed, 3)
+	assert.Equal(t, "NFT 30", unclaimed[0].Name)
+}
+
+func TestPaginationIterator(t *testing.T) {
+	server, gateway, drop := newTestPaginationDrop(t)
+	defer server.Close()
+	defer gateway.Close()
+
+	iterator, err := drop.Iterate(context.Background(), 20)
+	assert.Nil(t, err)
+
+	pages := [][]*NFTMetadataOwner{}
+	for iterator.HasNext() {
+		nfts, err := iterator.Next(context.Background())
+		assert.Nil(t, err)
+		pages = append(pages, nfts)
+	}
+
+	assert.Len(t, pages, 3)
+	assert.Len(t, pages[2], 10)
+	assert.Equal(t, "NFT 49", pages[2][9].Metadata.Name)
+
+	nfts, err := iterator.Next(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, nfts, 0)
+
+	claimedIterator, err := drop.IterateClaimed(context.Background(), 20)
+	assert.Nil(t, err)
+	count := 0
+	for claimedIterator.HasNext() {
+		nfts, err := claimedIterator.Next(context.Background())
+		assert.Nil(t, err)
+		count += len(nfts)
+	}
+	assert.Equal(t, 25, count)
+
+	unclaimedIterator, err := drop.IterateUnclaimed(context.Background(), 20)
+	assert.Nil(t, err)
+	unclaimed := []*NFTMetadata{}
+	for unclaimedIterator.HasNext() {
+		nfts, err := unclaimedIterator.Next(context.Background())
+		assert.Nil(t, err)
+		unclaimed = append(unclaimed, nfts...)
+	}
+	assert.Len(t, unclaimed, 25)
+	assert.Equal(t, "NFT 25", unclaimed[0].Name)
+	assert.Equal(t, "NFT 49", unclaimed[24].Name)
+}
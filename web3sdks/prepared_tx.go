@@ -0,0 +1,290 @@
+package web3sdks
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	gethAbi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Builds the transaction of a contract call with the given transaction options
+type txBuilder func(opts *bind.TransactOpts) (*types.Transaction, error)
+
+// A contract call that is ready to be sent. Every write method of the SDK has a Prepare variant
+// returning a PreparedTx, which can be estimated, simulated, encoded, signed or sent.
+//
+// Any approval the transaction depends on, like the ERC20 allowance needed to pay for a claim, is
+// only sent by Send and SendAndWait, right before the transaction itself. Estimate, Simulate,
+// Encode and Sign expect the approval to already be in place.
+//
+// Example
+//
+//	tx, err := contract.PrepareTransfer(context.Background(), "{{wallet_address}}", 0)
+//
+//	// Check that the transaction succeeds and what it will cost before sending it
+//	_, err = tx.Simulate(context.Background())
+//	estimate, err := tx.Estimate(context.Background())
+//	fmt.Println(estimate.Cost.DisplayValue, estimate.Cost.Symbol)
+//
+//	result, err := tx.SendAndWait(context.Background())
+type PreparedTx struct {
+	// The address the transaction is sent from, defaults to the address of the signer of the SDK.
+	// It can be changed to encode, estimate or simulate the transaction for another wallet.
+	From common.Address
+
+	helper     *contractHelper
+	build      txBuilder
+	beforeSend func(ctx context.Context) error
+	// The ABI of the contract called, used to decode its custom errors
+	contractAbi *gethAbi.ABI
+}
+
+// The estimated gas and cost of a transaction in the native currency of the chain
+type TxCostEstimate struct {
+	// The gas limit of the transaction
+	Gas uint64
+	// The fees of the transaction, from the fee strategy of the SDK
+	Fees *GasFees
+	// The expected cost of the transaction at the current base fee, including the value sent
+	Cost *CurrencyValue
+	// The maximum cost of the transaction if the base fee rises up to the max fee, including the value sent
+	MaxCost *CurrencyValue
+}
+
+func newPreparedTx(helper *contractHelper, build txBuilder) *PreparedTx {
+	return &PreparedTx{
+		From:   helper.GetSignerAddress(),
+		helper: helper,
+		build:  build,
+	}
+}
+
+// Pay a price in the given currency with the transaction. Payments in the native token are sent as
+// the value of the transaction, while the allowance needed to pay in an ERC20 token is approved
+// right before the transaction is sent.
+func (tx *PreparedTx) withPayment(value *big.Int, currencyAddress string) *PreparedTx {
+	if isNativeToken(currencyAddress) {
+		build := tx.build
+		tx.build = func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			opts.Value = value
+			return build(opts)
+		}
+	} else {
+		tx.beforeSend = func(ctx context.Context) error {
+			return setErc20Allowance(ctx, tx.helper, value, currencyAddress, &bind.TransactOpts{Context: ctx})
+		}
+	}
+
+	return tx
+}
+
+// Get the unsigned transaction, with the nonce, gas limit and fees filled in.
+//
+// returns: the unsigned transaction, which can be signed at a later time
+//
+// Example
+//
+//	unsignedTx, err := tx.Encode(context.Background())
+//	fmt.Println(unsignedTx.Data())
+//	fmt.Println(unsignedTx.Nonce())
+func (tx *PreparedTx) Encode(ctx context.Context) (*types.Transaction, error) {
+	txOpts, err := tx.helper.getUnsignedTxOptions(ctx, tx.From.Hex())
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.build(txOpts)
+}
+
+// Estimate the gas and cost of the transaction.
+//
+// returns: the gas limit of the transaction and its cost in the native currency of the chain
+//
+// Example
+//
+//	estimate, err := tx.Estimate(context.Background())
+//	fmt.Println(estimate.Gas)
+//	fmt.Println(estimate.Cost.DisplayValue, estimate.Cost.Symbol)
+func (tx *PreparedTx) Estimate(ctx context.Context) (*TxCostEstimate, error) {
+	unsignedTx, err := tx.Encode(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := tx.helper.GetProvider()
+	estimate := &TxCostEstimate{Gas: unsignedTx.Gas()}
+
+	gasPrice := unsignedTx.GasPrice()
+	maxGasPrice := unsignedTx.GasPrice()
+	if unsignedTx.Type() == types.DynamicFeeTxType {
+		estimate.Fees = &GasFees{GasTipCap: unsignedTx.GasTipCap(), GasFeeCap: unsignedTx.GasFeeCap()}
+
+		header, err := provider.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		gasPrice = big.NewInt(0).Add(header.BaseFee, unsignedTx.EffectiveGasTipValue(header.BaseFee))
+		maxGasPrice = unsignedTx.GasFeeCap()
+	} else {
+		estimate.Fees = &GasFees{GasPrice: unsignedTx.GasPrice()}
+	}
+
+	gas := big.NewInt(int64(unsignedTx.Gas()))
+	cost := big.NewInt(0).Mul(gas, gasPrice)
+	maxCost := big.NewInt(0).Mul(gas, maxGasPrice)
+
+	if estimate.Cost, err = fetchCurrencyValue(ctx, provider, nativeTokenAddress, cost.Add(cost, unsignedTx.Value())); err != nil {
+		return nil, err
+	}
+	if estimate.MaxCost, err = fetchCurrencyValue(ctx, provider, nativeTokenAddress, maxCost.Add(maxCost, unsignedTx.Value())); err != nil {
+		return nil, err
+	}
+
+	return estimate, nil
+}
+
+// Simulate the transaction with an eth_call against the latest block, without sending it.
+//
+// returns: the data returned by the contract call, or a *SimulationRevertedError with the decoded
+// revert if the transaction would revert
+//
+// Example
+//
+//	_, err := tx.Simulate(context.Background())
+//
+//	var revertErr *web3sdks.SimulationRevertedError
+//	if errors.As(err, &revertErr) {
+//		fmt.Println(revertErr.Reason)
+//	}
+func (tx *PreparedTx) Simulate(ctx context.Context) ([]byte, error) {
+	// Only the call data and value of the transaction are needed, so skip the nonce, fee and gas
+	// lookups, the latter of which would fail before the call if the transaction reverts
+	txOpts := &bind.TransactOpts{
+		Context:  ctx,
+		NoSend:   true,
+		From:     tx.From,
+		Nonce:    big.NewInt(0),
+		GasPrice: big.NewInt(0),
+		GasLimit: 1,
+		Signer: func(address common.Address, transaction *types.Transaction) (*types.Transaction, error) {
+			return transaction, nil
+		},
+	}
+
+	unsignedTx, err := tx.build(txOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := ethereum.CallMsg{
+		From:  tx.From,
+		To:    unsignedTx.To(),
+		Value: unsignedTx.Value(),
+		Data:  unsignedTx.Data(),
+	}
+	result, err := tx.helper.GetProvider().CallContract(ctx, msg, nil)
+	if err != nil {
+		if revertErr, ok := DecodeRevertError(err, tx.contractAbi); ok {
+			return nil, &SimulationRevertedError{Reason: revertErr.Reason(), Data: revertErr.Data, Revert: revertErr}
+		}
+
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Sign the transaction with the signer of the SDK without sending it. Its nonce is taken from the
+// nonce manager of the SDK and held as if the transaction was sent, so that the transactions sent
+// by the SDK in the meantime don't reuse it. If the signed transaction is never sent, its nonce is
+// handed out again once the node is found not to know about it, after a few minutes.
+//
+// returns: the signed transaction, which can be sent at a later time
+//
+// Example
+//
+//	signedTx, err := tx.Sign(context.Background())
+//	rawTx, err := signedTx.MarshalBinary()
+func (tx *PreparedTx) Sign(ctx context.Context) (*types.Transaction, error) {
+	txOpts, err := tx.getSignerTxOptions(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	txOpts.Signer = tx.helper.withManagedNonce(ctx, txOpts.Signer)
+
+	signedTx, err := tx.build(txOpts)
+	if err != nil {
+		return nil, err
+	}
+	tx.helper.nonces.markSent(txOpts.From, signedTx.Nonce(), signedTx.Hash())
+
+	return signedTx, nil
+}
+
+// Sign and send the transaction without waiting for it to be mined.
+//
+// returns: the sent transaction, which can be waited for with AwaitTx
+//
+// Example
+//
+//	sentTx, err := tx.Send(context.Background())
+//	result, err := sdk.AwaitTx(context.Background(), sentTx.Hash())
+func (tx *PreparedTx) Send(ctx context.Context) (*types.Transaction, error) {
+	if tx.beforeSend != nil {
+		if err := tx.beforeSend(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	txOpts, err := tx.getSignerTxOptions(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.build(txOpts)
+}
+
+// Sign and send the transaction, and wait for it to be mined.
+//
+// returns: the transaction along with its receipt
+//
+// Example
+//
+//	result, err := tx.SendAndWait(context.Background())
+//	fmt.Println(result.Receipt.BlockNumber)
+func (tx *PreparedTx) SendAndWait(ctx context.Context) (*TransactionResult, error) {
+	sentTx, err := tx.Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.helper.AwaitTx(ctx, sentTx.Hash())
+}
+
+func (tx *PreparedTx) getSignerTxOptions(ctx context.Context, noSend bool) (*bind.TransactOpts, error) {
+	txOpts, err := tx.helper.getRawTxOptions(ctx, noSend)
+	if err != nil {
+		return nil, err
+	}
+
+	if tx.From != txOpts.From {
+		return nil, fmt.Errorf("Transaction from %s can't be signed by the SDK signer %s", tx.From.Hex(), txOpts.From.Hex())
+	}
+
+	return txOpts, nil
+}
+
+// Encode the call data of a contract function, used to batch several calls into a multicall
+func encodeCallData(metadata *bind.MetaData, method string, args ...interface{}) ([]byte, error) {
+	contractAbi, err := metadata.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	return contractAbi.Pack(method, args...)
+}
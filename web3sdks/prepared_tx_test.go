@@ -0,0 +1,129 @@
+package web3sdks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/web3sdks/go-sdk/v2/abi"
+)
+
+// Mock server able to build, simulate and send a transaction to the contract of the helper
+func newPreparedTxMockRpcServer(t *testing.T) *mockRpcServer {
+	server := newMockRpcServer()
+
+	server.handle("eth_chainId", func(params []json.RawMessage) (interface{}, error) {
+		return "0x1", nil
+	})
+	server.handle("eth_getBlockByNumber", func(params []json.RawMessage) (interface{}, error) {
+		return mockHeader(10, 10), nil
+	})
+	server.handle("eth_getCode", func(params []json.RawMessage) (interface{}, error) {
+		return "0x01", nil
+	})
+	server.handle("eth_getTransactionCount", func(params []json.RawMessage) (interface{}, error) {
+		return "0x5", nil
+	})
+	server.handle("eth_estimateGas", func(params []json.RawMessage) (interface{}, error) {
+		return "0xc350", nil
+	})
+	server.handle("eth_call", func(params []json.RawMessage) (interface{}, error) {
+		return hexutil.Encode(common.LeftPadBytes([]byte{1}, 32)), nil
+	})
+	server.handle("eth_sendRawTransaction", func(params []json.RawMessage) (interface{}, error) {
+		return common.Hash{}, nil
+	})
+
+	return server
+}
+
+func newTestPreparedTransfer(t *testing.T, server *mockRpcServer) *PreparedTx {
+	encoder, err := newContractEncoder(abi.TokenERC20ABI, server.helper(t))
+	assert.Nil(t, err)
+
+	tx, err := encoder.Prepare(context.Background(), "transfer", secondaryWallet, 100)
+	assert.Nil(t, err)
+
+	return tx
+}
+
+func TestPreparedTxEncodeForAnotherWallet(t *testing.T) {
+	server := newPreparedTxMockRpcServer(t)
+	defer server.Close()
+
+	tx := newTestPreparedTransfer(t, server)
+	tx.From = common.HexToAddress(secondaryWallet)
+
+	unsignedTx, err := tx.Encode(context.Background())
+	assert.Nil(t, err)
+
+	assert.Equal(t, uint64(5), unsignedTx.Nonce())
+	assert.Equal(t, uint64(50000), unsignedTx.Gas())
+	assert.Equal(t, common.HexToAddress(secondaryWallet), *unsignedTx.To())
+	assert.Equal(t, 0, server.callCount("eth_sendRawTransaction"))
+
+	// Transactions from another wallet can't be signed by the SDK
+	_, err = tx.Sign(context.Background())
+	assert.NotNil(t, err)
+}
+
+func TestPreparedTxEstimate(t *testing.T) {
+	server := newPreparedTxMockRpcServer(t)
+	defer server.Close()
+
+	estimate, err := newTestPreparedTransfer(t, server).Estimate(context.Background())
+	assert.Nil(t, err)
+
+	assert.Equal(t, uint64(50000), estimate.Gas)
+	assert.Equal(t, defaultGasTipCap, estimate.Fees.GasTipCap)
+
+	// The cost is paid at the base fee plus the tip, the max cost at the fee cap
+	gasPrice := big.NewInt(0).Add(big.NewInt(10), defaultGasTipCap)
+	assert.Equal(t, big.NewInt(0).Mul(gasPrice, big.NewInt(50000)), estimate.Cost.Value)
+	assert.Equal(t, big.NewInt(0).Mul(estimate.Fees.GasFeeCap, big.NewInt(50000)), estimate.MaxCost.Value)
+	assert.Equal(t, "ETH", estimate.Cost.Symbol)
+}
+
+func TestPreparedTxSimulateRevert(t *testing.T) {
+	server := newPreparedTxMockRpcServer(t)
+	defer server.Close()
+
+	server.handle("eth_call", func(params []json.RawMessage) (interface{}, error) {
+		return nil, mockRevertError("ERC20: transfer amount exceeds balance")
+	})
+
+	_, err := newTestPreparedTransfer(t, server).Simulate(context.Background())
+
+	var revertErr *SimulationRevertedError
+	assert.True(t, errors.As(err, &revertErr))
+	assert.Equal(t, "ERC20: transfer amount exceeds balance", revertErr.Reason)
+	assert.Equal(t, 0, server.callCount("eth_estimateGas"))
+}
+
+func TestPreparedTxSignAndSend(t *testing.T) {
+	server := newPreparedTxMockRpcServer(t)
+	defer server.Close()
+
+	tx := newTestPreparedTransfer(t, server)
+
+	signedTx, err := tx.Sign(context.Background())
+	assert.Nil(t, err)
+	sender, err := getTxSender(signedTx)
+	assert.Nil(t, err)
+	assert.Equal(t, tx.From, sender)
+	assert.Equal(t, uint64(5), signedTx.Nonce())
+	assert.Equal(t, 0, server.callCount("eth_sendRawTransaction"))
+
+	// The nonce of the signed transaction is held until it's sent, even though the node doesn't
+	// know about it yet
+	sentTx, err := tx.Send(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, server.callCount("eth_sendRawTransaction"))
+	assert.Equal(t, uint64(6), sentTx.Nonce())
+}
@@ -3,26 +3,37 @@ package web3sdks
 import (
 	"context"
 	"crypto/ecdsa"
+	"encoding/hex"
 	"errors"
 	"math/big"
 
+	gethAbi "github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 )
 
 type ProviderHandler struct {
-	provider      *ethclient.Client
-	privateKey    *ecdsa.PrivateKey
-	rawPrivateKey string
-	signerAddress common.Address
+	provider         *ethclient.Client
+	rpcClient        *rpc.Client
+	signer           Signer
+	txWaitOptions    *TxWaitOptions
+	feeStrategy      FeeStrategy
+	txType           TransactionType
+	createAccessList bool
+	nonces           *nonceManager
+	readBatch        *ReadBatchOptions
+	cache            *readCache
+	fetchConcurrency int
 }
 
 func NewProviderHandler(provider *ethclient.Client, privateKey string) (*ProviderHandler, error) {
 	handler := &ProviderHandler{
 		provider: provider,
+		nonces:   newNonceManager(),
 	}
 
 	if privateKey != "" {
@@ -34,8 +45,24 @@ func NewProviderHandler(provider *ethclient.Client, privateKey string) (*Provide
 	return handler, nil
 }
 
+func NewProviderHandlerWithSigner(provider *ethclient.Client, signer Signer) *ProviderHandler {
+	return &ProviderHandler{
+		provider: provider,
+		signer:   signer,
+		nonces:   newNonceManager(),
+	}
+}
+
 func (handler *ProviderHandler) UpdateProvider(provider *ethclient.Client) {
 	handler.provider = provider
+	handler.rpcClient = nil
+}
+
+// Update the provider from a raw RPC client. Unlike UpdateProvider, this also enables the
+// features that need direct access to the RPC client, like the FeeHistoryStrategy.
+func (handler *ProviderHandler) UpdateRpcClient(client *rpc.Client) {
+	handler.provider = ethclient.NewClient(client)
+	handler.rpcClient = client
 }
 
 func (handler *ProviderHandler) UpdatePrivateKey(privateKey string) error {
@@ -46,20 +73,68 @@ func (handler *ProviderHandler) UpdatePrivateKey(privateKey string) error {
 	}
 }
 
+func (handler *ProviderHandler) UpdateSigner(signer Signer) {
+	handler.signer = signer
+}
+
+// Set the default options used to wait for transactions sent through this handler.
+func (handler *ProviderHandler) SetTxWaitOptions(options *TxWaitOptions) {
+	handler.txWaitOptions = options
+}
+
+// Set the fee strategy used for transactions sent through this handler.
+func (handler *ProviderHandler) SetFeeStrategy(strategy FeeStrategy) {
+	handler.feeStrategy = strategy
+}
+
+// Set the type of transactions sent through this handler.
+func (handler *ProviderHandler) SetTransactionType(txType TransactionType) {
+	handler.txType = txType
+}
+
+// Enable or disable generating access lists with eth_createAccessList for transactions sent
+// through this handler. Only access list and dynamic fee transactions can include an access list.
+func (handler *ProviderHandler) SetCreateAccessList(createAccessList bool) {
+	handler.createAccessList = createAccessList
+}
+
 func (handler *ProviderHandler) GetProvider() *ethclient.Client {
 	return handler.provider
 }
 
+// Get the raw RPC client of the provider, or nil if the handler was created from an ethclient.Client.
+func (handler *ProviderHandler) GetRpcClient() *rpc.Client {
+	return handler.rpcClient
+}
+
+func (handler *ProviderHandler) GetSigner() Signer {
+	return handler.signer
+}
+
 func (handler *ProviderHandler) GetSignerAddress() common.Address {
-	return handler.signerAddress
+	if handler.signer == nil {
+		return common.Address{}
+	}
+
+	return handler.signer.GetAddress()
 }
 
+// Deprecated: use GetSigner instead, this returns an empty string unless the signer is a PrivateKeySigner
 func (handler *ProviderHandler) GetRawPrivateKey() string {
-	return handler.rawPrivateKey
+	if key := handler.GetPrivateKey(); key != nil {
+		return hex.EncodeToString(crypto.FromECDSA(key))
+	}
+
+	return ""
 }
 
+// Deprecated: use GetSigner instead, this returns nil unless the signer is a PrivateKeySigner
 func (handler *ProviderHandler) GetPrivateKey() *ecdsa.PrivateKey {
-	return handler.privateKey
+	if signer, ok := handler.signer.(*PrivateKeySigner); ok {
+		return signer.GetPrivateKey()
+	}
+
+	return nil
 }
 
 func (handler *ProviderHandler) GetChainID(ctx context.Context) (*big.Int, error) {
@@ -67,22 +142,106 @@ func (handler *ProviderHandler) GetChainID(ctx context.Context) (*big.Int, error
 }
 
 func (handler *ProviderHandler) getSigner(ctx context.Context) (bind.SignerFn, error) {
+	if handler.signer == nil {
+		return nil, ErrNoSigner
+	}
+
 	chainId, err := handler.GetChainID(ctx)
 	if err != nil {
 		return nil, err
 	}
+
+	signer := handler.signer
 	return func(address common.Address, transaction *types.Transaction) (*types.Transaction, error) {
-		return types.SignTx(transaction, types.LatestSignerForChainID(chainId), handler.privateKey)
+		if address != signer.GetAddress() {
+			return nil, bind.ErrNotAuthorized
+		}
+
+		transaction, err := handler.prepareTx(ctx, address, transaction, chainId)
+		if err != nil {
+			return nil, err
+		}
+
+		return signer.SignTx(ctx, transaction, chainId)
 	}, nil
 }
 
+// Get the fees for a new transaction of the configured transaction type. Dynamic fee transactions
+// get a tip and fee cap, while legacy and access list transactions get a gas price.
+func (handler *ProviderHandler) getGasFees(ctx context.Context) (*GasFees, error) {
+	var strategy FeeStrategy = &FixedFeeStrategy{}
+	if handler.feeStrategy != nil {
+		strategy = handler.feeStrategy
+	}
+
+	txType := handler.txType
+	if txType == TransactionTypeAuto || txType == TransactionTypeDynamicFee {
+		header, err := handler.provider.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if txType, err = handler.resolveTransactionType(header); err != nil {
+			return nil, err
+		}
+
+		if txType == TransactionTypeDynamicFee {
+			return strategy.GetFees(ctx, handler, header.BaseFee)
+		}
+	}
+
+	// Strategies return a gas price when there is no base fee
+	return strategy.GetFees(ctx, handler, nil)
+}
+
+// Get the backend used by contract bindings to call and transact through this handler. Reverts are
+// decoded with the custom errors of the given contract ABIs along with the prebuilt ones.
+func (handler *ProviderHandler) getContractBackend(contractAbis ...*gethAbi.ABI) *contractBackend {
+	return &contractBackend{handler, contractAbis}
+}
+
+// Send a signed transaction and mark its nonce, which was acquired when signing it, as sent. The
+// nonce is released if the transaction failed to be sent
+func (handler *ProviderHandler) sendTransaction(ctx context.Context, tx *types.Transaction) error {
+	err := handler.provider.SendTransaction(ctx, tx)
+	if sender, senderErr := getTxSender(tx); senderErr == nil {
+		if err == nil {
+			handler.nonces.markSent(sender, tx.Nonce(), tx.Hash())
+		} else {
+			handler.nonces.release(sender, tx.Nonce())
+		}
+	}
+	if err == nil {
+		handler.InvalidateCache()
+	}
+
+	return err
+}
+
+// Wrap a signer function to sign every transaction with a nonce from the nonce manager. The nonce
+// is released right away if signing fails, and marked as sent once the node accepts the transaction.
+func (handler *ProviderHandler) withManagedNonce(ctx context.Context, signerFn bind.SignerFn) bind.SignerFn {
+	return func(address common.Address, transaction *types.Transaction) (*types.Transaction, error) {
+		nonce, err := handler.nonces.acquire(ctx, handler, address)
+		if err != nil {
+			return nil, err
+		}
+
+		signedTx, err := signerFn(address, withNonce(transaction, nonce))
+		if err != nil {
+			handler.nonces.release(address, nonce)
+			return nil, err
+		}
+
+		return signedTx, nil
+	}
+}
+
 func (handler *ProviderHandler) updateAccount(privateKey string) error {
-	if key, publicAddress, err := processPrivateKey(privateKey); err != nil {
+	if signer, err := NewPrivateKeySigner(privateKey); err != nil {
 		return err
 	} else {
-		handler.privateKey = key
-		handler.signerAddress = publicAddress
-		handler.rawPrivateKey = privateKey
+		handler.signer = signer
 		return nil
 	}
 }
@@ -0,0 +1,262 @@
+package web3sdks
+
+import (
+	"context"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	gethAbi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/web3sdks/go-sdk/v2/abi"
+)
+
+const (
+	defaultMulticallSize = 500
+	defaultRpcBatchSize  = 100
+)
+
+// Options controlling how the prebuilt contracts aggregate bulk reads, like getting all the NFTs of
+// a collection, set with the ReadBatch field of the SDKOptions.
+//
+// Reads of the same contract are aggregated into calls to the multicall function of the contract,
+// which all the prebuilt contracts implement, and these calls are sent together in JSON-RPC batch
+// requests when the SDK has access to the RPC client. When a multicall fails, for example because
+// one of its reads reverts or the contract doesn't implement multicall, its reads are sent again
+// individually so that every read gets its own result.
+type ReadBatchOptions struct {
+	// Send every read as its own eth_call instead of aggregating them
+	Disabled bool
+	// Maximum number of reads aggregated in a single multicall, defaults to 500
+	MulticallSize int
+	// Maximum number of calls sent in a single JSON-RPC batch request, defaults to 100
+	RpcBatchSize int
+}
+
+// A read of a contract method, aggregated with other reads by batchRead
+type contractRead struct {
+	to          common.Address
+	contractAbi *gethAbi.ABI
+	method      string
+	data        []byte
+}
+
+type readResult struct {
+	read *contractRead
+	data []byte
+	err  error
+}
+
+func newContractRead(to common.Address, contractAbi *gethAbi.ABI, method string, args ...interface{}) (*contractRead, error) {
+	data, err := contractAbi.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contractRead{
+		to:          to,
+		contractAbi: contractAbi,
+		method:      method,
+		data:        data,
+	}, nil
+}
+
+// Unpack the outputs of the read method into a struct, or a pointer to the type of its single output
+func (result *readResult) unpackInto(v interface{}) error {
+	if result.err != nil {
+		return result.err
+	}
+
+	return result.read.contractAbi.UnpackIntoInterface(v, result.read.method, result.data)
+}
+
+// Set the options controlling how the prebuilt contracts aggregate bulk reads.
+func (handler *ProviderHandler) SetReadBatchOptions(options *ReadBatchOptions) {
+	handler.readBatch = options
+}
+
+func (handler *ProviderHandler) getReadBatchOptions() ReadBatchOptions {
+	options := ReadBatchOptions{}
+	if handler.readBatch != nil {
+		options = *handler.readBatch
+	}
+
+	if options.MulticallSize <= 0 {
+		options.MulticallSize = defaultMulticallSize
+	}
+	if options.RpcBatchSize <= 0 {
+		options.RpcBatchSize = defaultRpcBatchSize
+	}
+
+	return options
+}
+
+// Execute reads with as few requests as possible. The results are in the same order as the reads,
+// and a failed read doesn't prevent the others from succeeding.
+func (handler *ProviderHandler) batchRead(ctx context.Context, reads []*contractRead) []*readResult {
+	cache := handler.cache
+	if cache == nil {
+		return handler.sendReads(ctx, reads)
+	}
+
+	// Only send the reads that aren't cached
+	results := make([]*readResult, len(reads))
+	keys := make([]string, len(reads))
+	policies := make([]CachePolicy, len(reads))
+	missing := []*contractRead{}
+	missingIndexes := []int{}
+	for i, read := range reads {
+		to := read.to
+		key, policy, cacheable := cache.getCallKey(ctx, handler, ethereum.CallMsg{To: &to, Data: read.data})
+		if cacheable {
+			if data, ok := cache.get(key); ok {
+				results[i] = &readResult{read: read, data: data}
+				continue
+			}
+			keys[i], policies[i] = key, policy
+		}
+
+		missing = append(missing, read)
+		missingIndexes = append(missingIndexes, i)
+	}
+
+	for i, result := range handler.sendReads(ctx, missing) {
+		index := missingIndexes[i]
+		results[index] = result
+		if result.err == nil && keys[index] != "" {
+			cache.set(keys[index], policies[index], result.data)
+		}
+	}
+
+	return results
+}
+
+// Send reads aggregated in multicalls, retrying the reads of the failed multicalls individually
+func (handler *ProviderHandler) sendReads(ctx context.Context, reads []*contractRead) []*readResult {
+	options := handler.getReadBatchOptions()
+	if options.Disabled {
+		return handler.callAll(ctx, reads, 1)
+	}
+
+	multicallAbi, err := abi.MulticallMetaData.GetAbi()
+	if err != nil {
+		return handler.callAll(ctx, reads, options.RpcBatchSize)
+	}
+
+	// Group the reads of every contract in multicalls, keeping track of the reads in each of them
+	multicalls := []*contractRead{}
+	multicallReads := [][]int{}
+	pending := map[common.Address]int{}
+	for i, read := range reads {
+		index, ok := pending[read.to]
+		if !ok || len(multicallReads[index]) >= options.MulticallSize {
+			index = len(multicalls)
+			pending[read.to] = index
+			multicalls = append(multicalls, &contractRead{to: read.to, contractAbi: multicallAbi, method: "multicall"})
+			multicallReads = append(multicallReads, []int{})
+		}
+		multicallReads[index] = append(multicallReads[index], i)
+	}
+
+	results := make([]*readResult, len(reads))
+	for i, multicall := range multicalls {
+		calls := [][]byte{}
+		for _, index := range multicallReads[i] {
+			calls = append(calls, reads[index].data)
+		}
+
+		if multicall.data, err = multicallAbi.Pack("multicall", calls); err != nil {
+			return handler.callAll(ctx, reads, options.RpcBatchSize)
+		}
+	}
+
+	// Reads of failed multicalls are retried individually
+	retries := []*contractRead{}
+	retryIndexes := []int{}
+	for i, result := range handler.callAll(ctx, multicalls, options.RpcBatchSize) {
+		indexes := multicallReads[i]
+
+		var outputs [][]byte
+		if result.err == nil {
+			result.err = result.unpackInto(&outputs)
+		}
+		if result.err != nil || len(outputs) != len(indexes) {
+			for _, index := range indexes {
+				retries = append(retries, reads[index])
+				retryIndexes = append(retryIndexes, index)
+			}
+			continue
+		}
+
+		for j, index := range indexes {
+			results[index] = &readResult{read: reads[index], data: outputs[j]}
+		}
+	}
+
+	for i, result := range handler.callAll(ctx, retries, options.RpcBatchSize) {
+		results[retryIndexes[i]] = result
+	}
+
+	return results
+}
+
+// Send every read as its own eth_call, in JSON-RPC batches of up to batchSize calls when the RPC
+// client is available
+func (handler *ProviderHandler) callAll(ctx context.Context, reads []*contractRead, batchSize int) []*readResult {
+	results := make([]*readResult, len(reads))
+
+	if handler.rpcClient == nil || batchSize <= 1 {
+		for i, read := range reads {
+			to := read.to
+			data, err := handler.provider.CallContract(ctx, ethereum.CallMsg{To: &to, Data: read.data}, nil)
+			results[i] = newReadResult(read, data, err)
+		}
+
+		return results
+	}
+
+	for start := 0; start < len(reads); start += batchSize {
+		end := start + batchSize
+		if end > len(reads) {
+			end = len(reads)
+		}
+
+		outputs := make([]hexutil.Bytes, end-start)
+		elems := make([]rpc.BatchElem, end-start)
+		for i, read := range reads[start:end] {
+			elems[i] = rpc.BatchElem{
+				Method: "eth_call",
+				Args: []interface{}{
+					map[string]interface{}{"to": read.to, "data": hexutil.Bytes(read.data)},
+					"latest",
+				},
+				Result: &outputs[i],
+			}
+		}
+
+		err := handler.rpcClient.BatchCallContext(ctx, elems)
+		for i, read := range reads[start:end] {
+			if err != nil {
+				results[start+i] = newReadResult(read, nil, err)
+			} else {
+				results[start+i] = newReadResult(read, outputs[i], elems[i].Error)
+			}
+		}
+	}
+
+	return results
+}
+
+func newReadResult(read *contractRead, data []byte, err error) *readResult {
+	if err != nil {
+		return &readResult{read: read, err: withDecodedRevert(err, read.contractAbi)}
+	}
+
+	// Calling an address without code succeeds with no output
+	if len(data) == 0 {
+		return &readResult{read: read, err: bind.ErrNoCode}
+	}
+
+	return &readResult{read: read, data: data}
+}
@@ -0,0 +1,193 @@
+package web3sdks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/web3sdks/go-sdk/v2/abi"
+)
+
+// Mock the eth_call of an NFT drop implementing multicall with the given number of tokens, where
+// the first half of the tokens are claimed, the even tokens are owned by the given owner and the
+// missing tokens revert
+func handleMockDropCalls(t *testing.T, server *mockRpcServer, total int64, owner common.Address, missing map[int64]bool) {
+	dropAbi, err := abi.DropERC721MetaData.GetAbi()
+	assert.Nil(t, err)
+
+	var respond func(data []byte) ([]byte, error)
+	respond = func(data []byte) ([]byte, error) {
+		method, err := dropAbi.MethodById(data[:4])
+		if err != nil {
+			return nil, err
+		}
+
+		args, err := method.Inputs.Unpack(data[4:])
+		if err != nil {
+			return nil, err
+		}
+
+		switch method.Name {
+		case "multicall":
+			results := [][]byte{}
+			for _, call := range args[0].([][]byte) {
+				result, err := respond(call)
+				if err != nil {
+					return nil, err
+				}
+				results = append(results, result)
+			}
+			return method.Outputs.Pack(results)
+		case "tokenURI":
+			return method.Outputs.Pack(fmt.Sprintf("ipfs://QmHash/%d", args[0].(*big.Int).Int64()))
+		case "supportsInterface":
+			return method.Outputs.Pack(args[0].([4]byte) == [4]byte{0x80, 0xAC, 0x58, 0xCD})
+		case "nextTokenIdToMint":
+			return method.Outputs.Pack(big.NewInt(total))
+		case "nextTokenIdToClaim":
+			return method.Outputs.Pack(big.NewInt(total / 2))
+		case "ownerOf":
+			tokenId := args[0].(*big.Int).Int64()
+			if missing[tokenId] {
+				return nil, mockRevertError("ERC721: invalid token ID")
+			}
+			if tokenId%2 == 0 {
+				return method.Outputs.Pack(owner)
+			}
+			return method.Outputs.Pack(common.HexToAddress(secondaryWallet))
+		}
+
+		return nil, errors.New("Unexpected method " + method.Name)
+	}
+
+	server.handle("eth_call", func(params []json.RawMessage) (interface{}, error) {
+		msg := struct {
+			Data  hexutil.Bytes `json:"data"`
+			Input hexutil.Bytes `json:"input"`
+		}{}
+		if err := json.Unmarshal(params[0], &msg); err != nil {
+			return nil, err
+		}
+		if len(msg.Data) == 0 {
+			msg.Data = msg.Input
+		}
+
+		result, err := respond(msg.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		return hexutil.Encode(result), nil
+	})
+}
+
+func newTestOwnerOfReads(t *testing.T, count int) []*contractRead {
+	dropAbi, err := abi.DropERC721MetaData.GetAbi()
+	assert.Nil(t, err)
+
+	reads := []*contractRead{}
+	for i := 0; i < count; i++ {
+		read, err := newContractRead(common.HexToAddress(secondaryWallet), dropAbi, "ownerOf", big.NewInt(int64(i)))
+		assert.Nil(t, err)
+		reads = append(reads, read)
+	}
+
+	return reads
+}
+
+func TestBatchReadAggregatesReads(t *testing.T) {
+	server := newMockRpcServer()
+	defer server.Close()
+
+	owner := common.HexToAddress(adminWallet)
+	handleMockDropCalls(t, server, 1000, owner, nil)
+
+	helper := server.helper(t)
+	helper.SetReadBatchOptions(&ReadBatchOptions{MulticallSize: 100, RpcBatchSize: 5})
+
+	results := helper.batchRead(context.Background(), newTestOwnerOfReads(t, 1000))
+	for i, result := range results {
+		address := common.Address{}
+		assert.Nil(t, result.unpackInto(&address))
+		if i%2 == 0 {
+			assert.Equal(t, owner, address)
+		}
+	}
+
+	// 10 multicalls sent in 2 batches
+	assert.Equal(t, 10, server.callCount("eth_call"))
+	assert.Equal(t, 2, server.requestCount())
+}
+
+func TestBatchReadRetriesFailedMulticalls(t *testing.T) {
+	server := newMockRpcServer()
+	defer server.Close()
+
+	handleMockDropCalls(t, server, 10, common.HexToAddress(adminWallet), map[int64]bool{3: true})
+
+	helper := server.helper(t)
+	results := helper.batchRead(context.Background(), newTestOwnerOfReads(t, 10))
+
+	for i, result := range results {
+		address := common.Address{}
+		err := result.unpackInto(&address)
+		if i == 3 {
+			var revertErr *RevertError
+			assert.True(t, errors.As(err, &revertErr))
+			assert.Equal(t, "ERC721: invalid token ID", revertErr.Reason())
+		} else {
+			assert.Nil(t, err)
+		}
+	}
+
+	// The reverted multicall, then every read individually in a single batch
+	assert.Equal(t, 11, server.callCount("eth_call"))
+	assert.Equal(t, 2, server.requestCount())
+}
+
+func TestBatchReadWithoutRpcClient(t *testing.T) {
+	server := newMockRpcServer()
+	defer server.Close()
+
+	handleMockDropCalls(t, server, 200, common.HexToAddress(adminWallet), nil)
+
+	helper := server.helper(t)
+	helper.UpdateProvider(ethclient.NewClient(helper.GetRpcClient()))
+	helper.SetReadBatchOptions(&ReadBatchOptions{MulticallSize: 100})
+
+	results := helper.batchRead(context.Background(), newTestOwnerOfReads(t, 200))
+	assert.Len(t, results, 200)
+	assert.Equal(t, 2, server.requestCount())
+
+	helper.SetReadBatchOptions(&ReadBatchOptions{Disabled: true})
+	helper.batchRead(context.Background(), newTestOwnerOfReads(t, 10))
+	assert.Equal(t, 12, server.requestCount())
+}
+
+func TestBatchReadGetOwnedTokenIDs(t *testing.T) {
+	server := newMockRpcServer()
+	defer server.Close()
+
+	owner := common.HexToAddress(adminWallet)
+	handleMockDropCalls(t, server, 10000, owner, nil)
+
+	helper := server.helper(t)
+	drop, err := newNFTDrop(helper.GetProvider(), helper.getAddress(), helper.ProviderHandler, newIpfsStorage(defaultIpfsGatewayUrl, http.DefaultClient))
+	assert.Nil(t, err)
+
+	tokenIds, err := drop.GetOwnedTokenIDs(context.Background(), owner.String())
+	assert.Nil(t, err)
+	assert.Len(t, tokenIds, 5000)
+	assert.Equal(t, int64(9998), tokenIds[4999].Int64())
+
+	// The total count, then all the owners in a single batch of 20 multicalls
+	assert.Equal(t, 2, server.requestCount())
+}
@@ -0,0 +1,388 @@
+package web3sdks
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	defaultCacheSize                 = 10000
+	defaultCacheTTL                  = time.Minute
+	defaultCacheBlockRefreshInterval = time.Second
+)
+
+// How the result of a read is cached.
+type CachePolicy int
+
+const (
+	// Cache the result for the block it was read at, for data like balances and owners. This is the
+	// policy of contract methods without a policy of their own
+	CachePolicyBlock CachePolicy = iota
+	// Never cache the result
+	CachePolicyNone
+	// Cache the result forever, for data that never changes like the decimals of a currency or
+	// content addressed IPFS files
+	CachePolicyImmutable
+	// Cache the result for the TTL of the CacheOptions, for data that rarely changes like token URIs
+	CachePolicyTTL
+)
+
+// Default cache policies of contract methods by name
+var defaultCachePolicies = map[string]CachePolicy{
+	"name":              CachePolicyImmutable,
+	"symbol":            CachePolicyImmutable,
+	"decimals":          CachePolicyImmutable,
+	"supportsInterface": CachePolicyImmutable,
+	"tokenURI":          CachePolicyTTL,
+	"uri":               CachePolicyTTL,
+	"contractURI":       CachePolicyTTL,
+	"multicall":         CachePolicyNone,
+}
+
+// Options of the read cache, set with the Cache field of the SDKOptions. Reads are only cached when
+// these options are set.
+//
+// Contract reads are cached according to the policy of their method, and the content fetched from
+// storage is cached forever for IPFS URIs and for the TTL otherwise. Every transaction sent by the
+// SDK invalidates the cached reads, except the immutable ones, once when it is sent and once when it
+// is mined.
+type CacheOptions struct {
+	// The backend storing the cached reads, defaults to an in-memory LRU cache of 10,000 entries. It
+	// can be shared by SDKs connected to different chains, since the keys include the chain ID
+	Backend Cache
+	// How long the reads with the CachePolicyTTL policy are cached, defaults to 1 minute. Reads with
+	// the CachePolicyBlock policy also expire after this duration
+	TTL time.Duration
+	// How often the latest block number is refreshed, defaults to 1 second. Reads with the
+	// CachePolicyBlock policy can be up to this much behind the latest block
+	BlockRefreshInterval time.Duration
+	// Cache policies of contract methods by name, like "balanceOf", overriding the default policies
+	MethodPolicies map[string]CachePolicy
+}
+
+// A backend storing the cached reads, which must be safe for concurrent use.
+type Cache interface {
+	// Get a value, returning false if there is no value for the key or it expired
+	Get(key string) ([]byte, bool)
+	// Set a value, expiring after the ttl unless it is 0
+	Set(key string, value []byte, ttl time.Duration)
+}
+
+// An in-memory Cache evicting the least recently used values once it is full.
+type LRUCache struct {
+	mu      sync.Mutex
+	size    int
+	entries *list.List
+	items   map[string]*list.Element
+}
+
+type lruEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+var _ Cache = (*LRUCache)(nil)
+
+// Create an in-memory LRU cache.
+//
+// size: the maximum number of values in the cache
+//
+// returns: the LRU cache
+//
+// Example
+//
+//	sdk, err := web3sdks.NewWeb3sdksSDK("mumbai", &web3sdks.SDKOptions{
+//		Cache: &web3sdks.CacheOptions{Backend: web3sdks.NewLRUCache(50000)},
+//	})
+func NewLRUCache(size int) *LRUCache {
+	return &LRUCache{
+		size:    size,
+		entries: list.New(),
+		items:   map[string]*list.Element{},
+	}
+}
+
+func (cache *LRUCache) Get(key string) ([]byte, bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	element, ok := cache.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := element.Value.(*lruEntry)
+	if !entry.expires.IsZero() && time.Now().After(entry.expires) {
+		cache.entries.Remove(element)
+		delete(cache.items, key)
+		return nil, false
+	}
+
+	cache.entries.MoveToFront(element)
+	return entry.value, true
+}
+
+func (cache *LRUCache) Set(key string, value []byte, ttl time.Duration) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	entry := &lruEntry{key: key, value: value}
+	if ttl > 0 {
+		entry.expires = time.Now().Add(ttl)
+	}
+
+	if element, ok := cache.items[key]; ok {
+		element.Value = entry
+		cache.entries.MoveToFront(element)
+		return
+	}
+
+	cache.items[key] = cache.entries.PushFront(entry)
+	for cache.size > 0 && cache.entries.Len() > cache.size {
+		oldest := cache.entries.Back()
+		cache.entries.Remove(oldest)
+		delete(cache.items, oldest.Value.(*lruEntry).key)
+	}
+}
+
+// The read cache shared by the provider handler and the storage of an SDK. Invalidating the cache
+// increments its generation, which is part of the key of every value that isn't immutable.
+//
+// Every key starts with the chain ID of the provider of the handler, so that SDKs connected to
+// different chains can share a backend without reading each other's values.
+type readCache struct {
+	backend              Cache
+	ttl                  time.Duration
+	blockRefreshInterval time.Duration
+	methodPolicies       map[string]CachePolicy
+	handler              *ProviderHandler
+
+	mu             sync.Mutex
+	chainId        *big.Int
+	generation     uint64
+	blockNumber    uint64
+	blockFetchedAt time.Time
+}
+
+func newReadCache(options *CacheOptions, handler *ProviderHandler) *readCache {
+	cache := &readCache{
+		handler:              handler,
+		backend:              options.Backend,
+		ttl:                  options.TTL,
+		blockRefreshInterval: options.BlockRefreshInterval,
+		methodPolicies:       map[string]CachePolicy{},
+	}
+
+	if cache.backend == nil {
+		cache.backend = NewLRUCache(defaultCacheSize)
+	}
+	if cache.ttl <= 0 {
+		cache.ttl = defaultCacheTTL
+	}
+	if cache.blockRefreshInterval <= 0 {
+		cache.blockRefreshInterval = defaultCacheBlockRefreshInterval
+	}
+
+	for method, policy := range defaultCachePolicies {
+		cache.methodPolicies[method] = policy
+	}
+	for method, policy := range options.MethodPolicies {
+		cache.methodPolicies[method] = policy
+	}
+
+	return cache
+}
+
+// Invalidate all the cached values except the immutable ones
+func (cache *readCache) invalidate() {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	cache.generation += 1
+	cache.blockFetchedAt = time.Time{}
+}
+
+// Get the chain ID of the provider, which is only read once. It's kept in memory instead of the
+// backend, which can be shared with SDKs connected to other chains
+func (cache *readCache) getChainId(ctx context.Context) (*big.Int, error) {
+	cache.mu.Lock()
+	chainId := cache.chainId
+	cache.mu.Unlock()
+	if chainId != nil {
+		return chainId, nil
+	}
+
+	chainId, err := cache.handler.GetProvider().ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	cache.chainId = chainId
+	return chainId, nil
+}
+
+// Get the key of a value with the given policy, prefixed with the chain ID. The key of values that
+// aren't immutable includes the current generation, so it must be computed before fetching the
+// value, otherwise a value fetched before an invalidation could be cached after it.
+func (cache *readCache) getKey(ctx context.Context, key string, policy CachePolicy) (string, error) {
+	chainId, err := cache.getChainId(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if policy == CachePolicyImmutable {
+		return fmt.Sprintf("%s:%s", chainId, key), nil
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	return fmt.Sprintf("%s:%d:%s", chainId, cache.generation, key), nil
+}
+
+func (cache *readCache) get(key string) ([]byte, bool) {
+	return cache.backend.Get(key)
+}
+
+func (cache *readCache) set(key string, policy CachePolicy, value []byte) {
+	ttl := cache.ttl
+	if policy == CachePolicyImmutable {
+		ttl = 0
+	}
+
+	cache.backend.Set(key, value, ttl)
+}
+
+// Get the key and policy of a contract call made at the latest block. Returns false if the call
+// must not be cached.
+func (cache *readCache) getCallKey(ctx context.Context, handler *ProviderHandler, call ethereum.CallMsg) (string, CachePolicy, bool) {
+	if call.To == nil || len(call.Data) < 4 || (call.Value != nil && call.Value.Sign() != 0) {
+		return "", CachePolicyNone, false
+	}
+
+	policy := cache.getMethodPolicy(call.Data[:4])
+	key := fmt.Sprintf("call:%s:%s:%s", call.To.Hex(), call.From.Hex(), hexutil.Encode(call.Data))
+
+	switch policy {
+	case CachePolicyNone:
+		return "", policy, false
+	case CachePolicyBlock:
+		blockNumber, err := cache.getBlockNumber(ctx, handler)
+		if err != nil {
+			return "", policy, false
+		}
+		key = fmt.Sprintf("block:%d:%s", blockNumber, key)
+	}
+
+	key, err := cache.getKey(ctx, key, policy)
+	if err != nil {
+		return "", policy, false
+	}
+
+	return key, policy, true
+}
+
+// Call a contract through the cache, calling it only if its result isn't cached
+func (cache *readCache) call(
+	ctx context.Context,
+	handler *ProviderHandler,
+	call ethereum.CallMsg,
+	fetch func() ([]byte, error),
+) ([]byte, error) {
+	key, policy, cacheable := cache.getCallKey(ctx, handler, call)
+	if !cacheable {
+		return fetch()
+	}
+
+	if result, ok := cache.get(key); ok {
+		return result, nil
+	}
+
+	result, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	cache.set(key, policy, result)
+	return result, nil
+}
+
+func (cache *readCache) getMethodPolicy(selector []byte) CachePolicy {
+	name, ok := getPrebuiltMethodNames()[string(selector)]
+	if !ok {
+		return CachePolicyBlock
+	}
+
+	if policy, ok := cache.methodPolicies[name]; ok {
+		return policy
+	}
+
+	return CachePolicyBlock
+}
+
+// Get the latest block number, refreshed at most once every refresh interval
+func (cache *readCache) getBlockNumber(ctx context.Context, handler *ProviderHandler) (uint64, error) {
+	cache.mu.Lock()
+	if time.Since(cache.blockFetchedAt) < cache.blockRefreshInterval {
+		defer cache.mu.Unlock()
+		return cache.blockNumber, nil
+	}
+	generation := cache.generation
+	cache.mu.Unlock()
+
+	blockNumber, err := handler.GetProvider().BlockNumber(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	// Don't overwrite the block number if the cache was invalidated during the request
+	if cache.generation == generation && blockNumber >= cache.blockNumber {
+		cache.blockNumber = blockNumber
+		cache.blockFetchedAt = time.Now()
+	}
+
+	return blockNumber, nil
+}
+
+var (
+	prebuiltMethodNamesOnce sync.Once
+	prebuiltMethodNames     map[string]string
+)
+
+// Get the names of the methods of the prebuilt contracts by selector
+func getPrebuiltMethodNames() map[string]string {
+	prebuiltMethodNamesOnce.Do(func() {
+		prebuiltMethodNames = map[string]string{}
+		for _, metadata := range prebuiltAbis {
+			contractAbi, err := metadata.GetAbi()
+			if err != nil {
+				continue
+			}
+
+			for _, method := range contractAbi.Methods {
+				prebuiltMethodNames[string(method.ID)] = method.RawName
+			}
+		}
+	})
+
+	return prebuiltMethodNames
+}
+
+// Invalidate the cached reads, except the immutable ones. This is done automatically for the
+// transactions sent by the SDK, and only needs to be called after writes made outside of the SDK.
+func (handler *ProviderHandler) InvalidateCache() {
+	if handler.cache != nil {
+		handler.cache.invalidate()
+	}
+}
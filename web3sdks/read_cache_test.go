@@ -0,0 +1,279 @@
+package web3sdks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/web3sdks/go-sdk/v2/abi"
+)
+
+// Mock server of a token contract, returning the current value of blockNumber as the latest block
+func newCacheMockRpcServer(t *testing.T, blockNumber *int64) *mockRpcServer {
+	tokenAbi, err := abi.TokenERC20MetaData.GetAbi()
+	assert.Nil(t, err)
+
+	server := newMockRpcServer()
+	server.handle("eth_blockNumber", func(params []json.RawMessage) (interface{}, error) {
+		return hexutil.EncodeUint64(uint64(atomic.LoadInt64(blockNumber))), nil
+	})
+	server.handle("eth_call", func(params []json.RawMessage) (interface{}, error) {
+		msg := struct {
+			Data  hexutil.Bytes `json:"data"`
+			Input hexutil.Bytes `json:"input"`
+		}{}
+		json.Unmarshal(params[0], &msg)
+		if len(msg.Data) == 0 {
+			msg.Data = msg.Input
+		}
+
+		method, err := tokenAbi.MethodById(msg.Data[:4])
+		if err != nil {
+			return nil, err
+		}
+
+		var result []byte
+		switch method.Name {
+		case "decimals":
+			result, err = method.Outputs.Pack(uint8(18))
+		case "balanceOf":
+			result, err = method.Outputs.Pack(big.NewInt(atomic.LoadInt64(blockNumber)))
+		default:
+			return nil, fmt.Errorf("Unexpected method %s", method.Name)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return hexutil.Encode(result), nil
+	})
+	server.handle("eth_sendRawTransaction", func(params []json.RawMessage) (interface{}, error) {
+		return "0x0000000000000000000000000000000000000000000000000000000000000000", nil
+	})
+
+	return server
+}
+
+func newTestCachedToken(t *testing.T, server *mockRpcServer, options *CacheOptions) (*contractHelper, *abi.TokenERC20) {
+	helper := server.helper(t)
+	helper.cache = newReadCache(options, helper.ProviderHandler)
+
+	token, err := abi.NewTokenERC20(helper.getAddress(), helper.getContractBackend())
+	assert.Nil(t, err)
+
+	return helper, token
+}
+
+func TestReadCachePolicies(t *testing.T) {
+	blockNumber := int64(10)
+	server := newCacheMockRpcServer(t, &blockNumber)
+	defer server.Close()
+
+	_, token := newTestCachedToken(t, server, &CacheOptions{BlockRefreshInterval: time.Millisecond * 10})
+	opts := &bind.CallOpts{Context: context.Background()}
+	owner := common.HexToAddress(adminWallet)
+
+	// Immutable
+	for i := 0; i < 2; i++ {
+		decimals, err := token.Decimals(opts)
+		assert.Nil(t, err)
+		assert.Equal(t, uint8(18), decimals)
+	}
+	assert.Equal(t, 1, server.callCount("eth_call"))
+
+	// Cached for the block
+	for i := 0; i < 2; i++ {
+		balance, err := token.BalanceOf(opts, owner)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(10), balance.Int64())
+	}
+	assert.Equal(t, 2, server.callCount("eth_call"))
+
+	atomic.StoreInt64(&blockNumber, 11)
+	time.Sleep(time.Millisecond * 20)
+
+	balance, err := token.BalanceOf(opts, owner)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(11), balance.Int64())
+	_, err = token.Decimals(opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, server.callCount("eth_call"))
+
+	// Reads at a given block are never cached
+	_, err = token.BalanceOf(&bind.CallOpts{Context: context.Background(), BlockNumber: big.NewInt(11)}, owner)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, server.callCount("eth_call"))
+}
+
+func TestReadCacheSeparatesChains(t *testing.T) {
+	blockNumber := int64(10)
+	server := newCacheMockRpcServer(t, &blockNumber)
+	defer server.Close()
+
+	// The same token address on another chain, with other decimals
+	otherServer := newCacheMockRpcServer(t, &blockNumber)
+	defer otherServer.Close()
+	otherServer.handle("eth_chainId", func(params []json.RawMessage) (interface{}, error) {
+		return hexutil.EncodeUint64(137), nil
+	})
+	tokenAbi, err := abi.TokenERC20MetaData.GetAbi()
+	assert.Nil(t, err)
+	otherServer.handle("eth_call", func(params []json.RawMessage) (interface{}, error) {
+		result, err := tokenAbi.Methods["decimals"].Outputs.Pack(uint8(6))
+		return hexutil.Encode(result), err
+	})
+
+	backend := NewLRUCache(100)
+	helper, token := newTestCachedToken(t, server, &CacheOptions{Backend: backend})
+	otherHelper, otherToken := newTestCachedToken(t, otherServer, &CacheOptions{Backend: backend})
+	opts := &bind.CallOpts{Context: context.Background()}
+
+	for i := 0; i < 2; i++ {
+		decimals, err := token.Decimals(opts)
+		assert.Nil(t, err)
+		assert.Equal(t, uint8(18), decimals)
+
+		otherDecimals, err := otherToken.Decimals(opts)
+		assert.Nil(t, err)
+		assert.Equal(t, uint8(6), otherDecimals)
+	}
+	assert.Equal(t, 1, server.callCount("eth_call"))
+	assert.Equal(t, 1, otherServer.callCount("eth_call"))
+
+	// Each handler gets the chain ID of its own provider
+	chainId, err := helper.getContractBackend().ChainID(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1337), chainId.Int64())
+	chainId, err = otherHelper.getContractBackend().ChainID(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(137), chainId.Int64())
+}
+
+func TestReadCacheMethodPolicies(t *testing.T) {
+	blockNumber := int64(10)
+	server := newCacheMockRpcServer(t, &blockNumber)
+	defer server.Close()
+
+	_, token := newTestCachedToken(t, server, &CacheOptions{
+		MethodPolicies: map[string]CachePolicy{"decimals": CachePolicyNone},
+	})
+
+	for i := 0; i < 2; i++ {
+		_, err := token.Decimals(&bind.CallOpts{Context: context.Background()})
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, 2, server.callCount("eth_call"))
+}
+
+func TestReadCacheInvalidatesOnWrite(t *testing.T) {
+	blockNumber := int64(10)
+	server := newCacheMockRpcServer(t, &blockNumber)
+	defer server.Close()
+
+	helper, token := newTestCachedToken(t, server, &CacheOptions{})
+	opts := &bind.CallOpts{Context: context.Background()}
+	owner := common.HexToAddress(adminWallet)
+
+	_, err := token.BalanceOf(opts, owner)
+	assert.Nil(t, err)
+	_, err = token.Decimals(opts)
+	assert.Nil(t, err)
+
+	assert.Nil(t, helper.sendTransaction(context.Background(), newMockSignedTx(t)))
+
+	// The balance is read again, the decimals are still cached
+	_, err = token.BalanceOf(opts, owner)
+	assert.Nil(t, err)
+	_, err = token.Decimals(opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, server.callCount("eth_call"))
+}
+
+func TestReadCacheBatchRead(t *testing.T) {
+	server := newMockRpcServer()
+	defer server.Close()
+
+	handleMockDropCalls(t, server, 100, common.HexToAddress(adminWallet), nil)
+	server.handle("eth_blockNumber", func(params []json.RawMessage) (interface{}, error) {
+		return "0x10", nil
+	})
+
+	helper := server.helper(t)
+	helper.cache = newReadCache(&CacheOptions{}, helper.ProviderHandler)
+
+	helper.batchRead(context.Background(), newTestOwnerOfReads(t, 50))
+	assert.Equal(t, 1, server.callCount("eth_call"))
+
+	// Only the reads that aren't cached yet are sent
+	results := helper.batchRead(context.Background(), newTestOwnerOfReads(t, 100))
+	assert.Equal(t, 2, server.callCount("eth_call"))
+	for _, result := range results {
+		assert.Nil(t, result.err)
+	}
+}
+
+func TestReadCacheStorage(t *testing.T) {
+	var hits int32
+	gateway := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.Write([]byte(`{"name":"NFT"}`))
+	}))
+	defer gateway.Close()
+
+	server := newMockRpcServer()
+	defer server.Close()
+
+	storage := newIpfsStorage(gateway.URL, http.DefaultClient)
+	storage.cache = newReadCache(&CacheOptions{TTL: time.Millisecond * 10}, server.helper(t).ProviderHandler)
+
+	for i := 0; i < 2; i++ {
+		body, err := storage.Get(context.Background(), "ipfs://QmHash/0")
+		assert.Nil(t, err)
+		assert.Equal(t, `{"name":"NFT"}`, string(body))
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hits))
+
+	// Content that isn't addressed by its hash expires
+	for i := 0; i < 2; i++ {
+		_, err := storage.Get(context.Background(), gateway.URL+"/metadata/0")
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&hits))
+
+	time.Sleep(time.Millisecond * 20)
+	_, err := storage.Get(context.Background(), gateway.URL+"/metadata/0")
+	assert.Nil(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&hits))
+}
+
+func TestLRUCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	cache := NewLRUCache(2)
+	cache.Set("a", []byte("a"), 0)
+	cache.Set("b", []byte("b"), 0)
+
+	// Using a makes b the least recently used value
+	_, ok := cache.Get("a")
+	assert.True(t, ok)
+	cache.Set("c", []byte("c"), 0)
+
+	_, ok = cache.Get("b")
+	assert.False(t, ok)
+	value, ok := cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("a"), value)
+
+	cache.Set("d", []byte("d"), time.Millisecond)
+	time.Sleep(time.Millisecond * 5)
+	_, ok = cache.Get("d")
+	assert.False(t, ok)
+}
@@ -0,0 +1,196 @@
+package web3sdks
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"sync"
+
+	gethAbi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/web3sdks/go-sdk/v2/abi"
+)
+
+var (
+	// Selector of the Error(string) revert emitted by require and revert with a message
+	revertErrorSelector = common.FromHex("0x08c379a0")
+	// Selector of the Panic(uint256) revert emitted by failed assertions and arithmetic errors
+	revertPanicSelector = common.FromHex("0x4e487b71")
+)
+
+// Descriptions of the panic codes of the Solidity compiler
+var panicDescriptions = map[uint64]string{
+	0x00: "generic compiler panic",
+	0x01: "assertion failed",
+	0x11: "arithmetic underflow or overflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "invalid storage byte array encoding",
+	0x31: "pop on empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to uninitialized function",
+}
+
+// Prebuilt ABIs of the SDK whose custom errors can be decoded for any contract
+var prebuiltAbis = []*bind.MetaData{
+	abi.DropERC721MetaData,
+	abi.DropERC1155MetaData,
+	abi.TokenERC721MetaData,
+	abi.TokenERC1155MetaData,
+	abi.TokenERC20MetaData,
+	abi.MarketplaceMetaData,
+	abi.MultiwrapMetaData,
+	abi.TWFactoryMetaData,
+}
+
+var (
+	prebuiltErrorsOnce sync.Once
+	prebuiltErrors     []gethAbi.Error
+)
+
+// Decode the revert data returned by a contract. Error(string) and Panic(uint256) reverts are
+// always decoded, custom errors are looked up in the given contract ABIs first, and in the
+// prebuilt ABIs of the SDK after that.
+//
+// data: the revert data returned by the node
+//
+// contractAbis: the ABIs declaring the custom errors the contract can revert with
+//
+// returns: the decoded revert, with an empty name if the data couldn't be decoded
+//
+// Example
+//
+//	contractAbi, err := abi.JSON(strings.NewReader(abiJson))
+//	revertErr := web3sdks.DecodeRevertData(data, &contractAbi)
+//	fmt.Println(revertErr.Name, revertErr.Args)
+func DecodeRevertData(data []byte, contractAbis ...*gethAbi.ABI) *RevertError {
+	revertErr := &RevertError{Data: data}
+	if len(data) < 4 {
+		return revertErr
+	}
+
+	selector := data[:4]
+	if bytes.Equal(selector, revertErrorSelector) {
+		if reason, err := gethAbi.UnpackRevert(data); err == nil {
+			revertErr.Name = "Error"
+			revertErr.Args = []interface{}{reason}
+		}
+
+		return revertErr
+	}
+
+	if bytes.Equal(selector, revertPanicSelector) {
+		uint256Type, _ := gethAbi.NewType("uint256", "", nil)
+		if args, err := (gethAbi.Arguments{{Type: uint256Type}}).Unpack(data[4:]); err == nil {
+			revertErr.Name = "Panic"
+			revertErr.Args = args
+		}
+
+		return revertErr
+	}
+
+	errorAbis := []gethAbi.Error{}
+	for _, contractAbi := range contractAbis {
+		if contractAbi == nil {
+			continue
+		}
+		for _, abiError := range contractAbi.Errors {
+			errorAbis = append(errorAbis, abiError)
+		}
+	}
+	errorAbis = append(errorAbis, getPrebuiltErrors()...)
+
+	for _, abiError := range errorAbis {
+		if !bytes.Equal(selector, abiError.ID[:4]) {
+			continue
+		}
+
+		args, err := abiError.Inputs.Unpack(data[4:])
+		if err != nil {
+			continue
+		}
+
+		revertErr.Name = abiError.Name
+		revertErr.Args = args
+		return revertErr
+	}
+
+	return revertErr
+}
+
+// Decode the revert of a failed eth_call or gas estimation.
+//
+// err: the error returned by the node
+//
+// contractAbis: the ABIs declaring the custom errors the contract can revert with
+//
+// returns: the decoded revert, and false if the error isn't a revert
+//
+// Example
+//
+//	_, err := contract.Call(context.Background(), "transfer", "{{wallet_address}}", 1)
+//	if revertErr, ok := web3sdks.DecodeRevertError(err); ok {
+//		fmt.Println(revertErr.Name, revertErr.Args)
+//	}
+func DecodeRevertError(err error, contractAbis ...*gethAbi.ABI) (*RevertError, bool) {
+	var revertErr *RevertError
+	if errors.As(err, &revertErr) {
+		return revertErr, true
+	}
+
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		return nil, false
+	}
+
+	encoded, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return nil, false
+	}
+
+	return DecodeRevertData(common.FromHex(encoded), contractAbis...), true
+}
+
+// Replace the error of a node call with the decoded revert if the call reverted
+func withDecodedRevert(err error, contractAbis ...*gethAbi.ABI) error {
+	if revertErr, ok := DecodeRevertError(err, contractAbis...); ok {
+		return revertErr
+	}
+
+	return err
+}
+
+func getPrebuiltErrors() []gethAbi.Error {
+	prebuiltErrorsOnce.Do(func() {
+		seen := map[common.Hash]bool{}
+		for _, metadata := range prebuiltAbis {
+			contractAbi, err := metadata.GetAbi()
+			if err != nil {
+				continue
+			}
+
+			for _, abiError := range contractAbi.Errors {
+				if !seen[abiError.ID] {
+					seen[abiError.ID] = true
+					prebuiltErrors = append(prebuiltErrors, abiError)
+				}
+			}
+		}
+	})
+
+	return prebuiltErrors
+}
+
+func getPanicDescription(code *big.Int) string {
+	if code != nil && code.IsUint64() {
+		if description, ok := panicDescriptions[code.Uint64()]; ok {
+			return description
+		}
+	}
+
+	return "unknown panic code"
+}
@@ -0,0 +1,106 @@
+package web3sdks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	gethAbi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+const customErrorAbi = `[{"inputs":[{"internalType":"uint256","name":"available","type":"uint256"},{"internalType":"uint256","name":"required","type":"uint256"}],"name":"InsufficientBalance","type":"error"}]`
+
+func encodeCustomError(signature string, args ...interface{}) []byte {
+	selector := crypto.Keccak256([]byte(signature))[:4]
+	uint256Type, _ := gethAbi.NewType("uint256", "", nil)
+
+	arguments := gethAbi.Arguments{}
+	for range args {
+		arguments = append(arguments, gethAbi.Argument{Type: uint256Type})
+	}
+	packed, _ := arguments.Pack(args...)
+
+	return append(selector, packed...)
+}
+
+func TestDecodeRevertDataString(t *testing.T) {
+	data := common.FromHex(mockRevertError("!CONDITION").(*mockRpcError).Data.(string))
+
+	revertErr := DecodeRevertData(data)
+	assert.Equal(t, "Error", revertErr.Name)
+	assert.Equal(t, []interface{}{"!CONDITION"}, revertErr.Args)
+	assert.Equal(t, "Execution reverted: !CONDITION", revertErr.Error())
+	assert.True(t, errors.Is(revertErr, ErrReverted))
+}
+
+func TestDecodeRevertDataPanic(t *testing.T) {
+	revertErr := DecodeRevertData(encodeCustomError("Panic(uint256)", big.NewInt(0x11)))
+
+	assert.Equal(t, "Panic", revertErr.Name)
+	assert.Equal(t, []interface{}{big.NewInt(0x11)}, revertErr.Args)
+	assert.Equal(t, "Panic(0x11): arithmetic underflow or overflow", revertErr.Reason())
+}
+
+func TestDecodeRevertDataCustomError(t *testing.T) {
+	contractAbi, err := gethAbi.JSON(strings.NewReader(customErrorAbi))
+	assert.Nil(t, err)
+
+	data := encodeCustomError("InsufficientBalance(uint256,uint256)", big.NewInt(1), big.NewInt(2))
+
+	revertErr := DecodeRevertData(data, &contractAbi)
+	assert.Equal(t, "InsufficientBalance", revertErr.Name)
+	assert.Equal(t, []interface{}{big.NewInt(1), big.NewInt(2)}, revertErr.Args)
+	assert.Equal(t, "InsufficientBalance(1, 2)", revertErr.Reason())
+
+	// Without the ABI the error can't be decoded
+	revertErr = DecodeRevertData(data)
+	assert.Equal(t, "", revertErr.Name)
+	assert.Equal(t, data, revertErr.Data)
+}
+
+func TestDecodeRevertDataPrebuiltError(t *testing.T) {
+	revertErr := DecodeRevertData(encodeCustomError("TransferFromIncorrectOwner()"))
+
+	assert.Equal(t, "TransferFromIncorrectOwner", revertErr.Name)
+	assert.Empty(t, revertErr.Args)
+}
+
+func TestSmartContractCallDecodesCustomError(t *testing.T) {
+	server := newMockRpcServer()
+	defer server.Close()
+
+	server.handle("eth_call", func(params []json.RawMessage) (interface{}, error) {
+		data := encodeCustomError("InsufficientBalance(uint256,uint256)", big.NewInt(1), big.NewInt(2))
+		return nil, &mockRpcError{Code: 3, Message: "execution reverted", Data: hexutil.Encode(data)}
+	})
+
+	helper := server.helper(t)
+	contractAbi := strings.TrimSuffix(customErrorAbi, "]") +
+		`,{"inputs":[],"name":"balance","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"}]`
+	contract, err := newSmartContract(helper.GetProvider(), common.HexToAddress(secondaryWallet), contractAbi, helper.ProviderHandler, nil)
+	assert.Nil(t, err)
+
+	_, err = contract.Call(context.Background(), "balance")
+
+	var revertErr *RevertError
+	assert.True(t, errors.As(err, &revertErr))
+	assert.Equal(t, "InsufficientBalance", revertErr.Name)
+	assert.True(t, errors.Is(err, ErrReverted))
+}
+
+func TestErrorsMatchSentinels(t *testing.T) {
+	assert.True(t, errors.Is(&NotFoundError{TypeName: "listing", Identifier: 1}, ErrNotFound))
+	assert.True(t, errors.Is(&NoSignerError{TypeName: "signature"}, ErrNoSigner))
+	assert.True(t, errors.Is(&UploadFailedError{StatusCode: 500}, ErrUploadFailed))
+	assert.True(t, errors.Is(&TransactionRevertedError{}, ErrReverted))
+
+	_, err := (&ProviderHandler{}).SpeedUp(context.Background(), common.Hash{}, 1.5)
+	assert.True(t, errors.Is(err, ErrNoSigner))
+}
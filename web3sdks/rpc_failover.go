@@ -0,0 +1,512 @@
+package web3sdks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	defaultHealthCheckInterval = time.Second * 15
+	defaultMaxBlockLag         = 3
+	defaultMaxLatency          = time.Second * 3
+	defaultMaxErrorRate        = 0.5
+	// Weight of the latest sample in the moving averages of the latency and error rate
+	endpointSampleWeight = 0.2
+)
+
+// Methods pinned to a single endpoint, since they depend on the mempool of the node they're sent to
+var pinnedRpcMethods = map[string]bool{
+	"eth_sendRawTransaction":  true,
+	"eth_sendTransaction":     true,
+	"eth_getTransactionCount": true,
+}
+
+// Options controlling how requests fail over between several RPC endpoints, set with the Failover
+// field of the SDKOptions.
+type FailoverOptions struct {
+	// Time between health checks of the endpoints, defaults to 15 seconds
+	HealthCheckInterval time.Duration
+	// Number of blocks an endpoint can lag behind the most up to date endpoint and still be healthy, defaults to 3
+	MaxBlockLag uint64
+	// Latency above which an endpoint is unhealthy, also used as the timeout of health checks, defaults to 3 seconds
+	MaxLatency time.Duration
+	// Ratio of failed requests, between 0 and 1, above which an endpoint is unhealthy, defaults to 0.5
+	MaxErrorRate float64
+	// The HTTP client used to send the requests, defaults to http.DefaultClient
+	HttpClient *http.Client
+}
+
+// The health of an RPC endpoint as last measured by a FailoverTransport
+type EndpointHealth struct {
+	Url string
+	// The latest block number of the endpoint, 0 until it has been checked
+	BlockNumber uint64
+	// Moving average of the latency of the requests to the endpoint
+	Latency time.Duration
+	// Moving average of the ratio of failed requests to the endpoint
+	ErrorRate float64
+	Healthy   bool
+	// Whether transactions are currently sent to this endpoint
+	Pinned      bool
+	LastChecked time.Time
+}
+
+// An HTTP transport for JSON-RPC clients that spreads requests over several endpoints of the same
+// chain. Reads go to the healthiest endpoint and transparently fail over to the next one when a
+// request fails. Transactions are pinned to a single endpoint, and are only tried on another
+// endpoint if the connection to the pinned one could not be established, so a transaction is never
+// sent twice. The pinned endpoint moves to the healthiest one once it becomes unhealthy.
+//
+// Endpoints are health checked in the background every HealthCheckInterval while the transport is
+// in use. An endpoint is unhealthy when it lags more than MaxBlockLag blocks behind the most up to
+// date endpoint, is slower than MaxLatency, or fails more than MaxErrorRate of its requests.
+//
+// Example
+//
+//	transport, err := web3sdks.NewFailoverTransport(
+//		[]string{"https://rpc-1.example.com", "https://rpc-2.example.com"},
+//		&web3sdks.FailoverOptions{MaxBlockLag: 5},
+//	)
+//	client, err := transport.Dial()
+//	sdk, err := web3sdks.NewWeb3sdksSDKFromRpcClient(client, nil)
+type FailoverTransport struct {
+	options   FailoverOptions
+	endpoints []*rpcEndpoint
+
+	mu        sync.Mutex
+	pinned    int
+	lastCheck time.Time
+	checking  bool
+}
+
+type rpcEndpoint struct {
+	url         string
+	blockNumber uint64
+	latency     time.Duration
+	errorRate   float64
+	lastChecked time.Time
+}
+
+// Create a transport failing over between the given RPC URLs, which must all be HTTP endpoints of
+// the same chain. The first URL is pinned for transactions until it becomes unhealthy.
+//
+// rpcUrls: the URLs of the endpoints
+//
+// options: the failover options, or nil to use the defaults
+//
+// returns: the failover transport
+func NewFailoverTransport(rpcUrls []string, options *FailoverOptions) (*FailoverTransport, error) {
+	if len(rpcUrls) == 0 {
+		return nil, errors.New("At least one RPC URL is required")
+	}
+
+	transport := &FailoverTransport{options: options.withDefaults()}
+	for _, rpcUrl := range rpcUrls {
+		if !strings.HasPrefix(rpcUrl, "http") {
+			return nil, fmt.Errorf("Only HTTP RPC URLs support failover, got %s", rpcUrl)
+		}
+
+		transport.endpoints = append(transport.endpoints, &rpcEndpoint{url: rpcUrl})
+	}
+
+	return transport, nil
+}
+
+func (options *FailoverOptions) withDefaults() FailoverOptions {
+	filled := FailoverOptions{}
+	if options != nil {
+		filled = *options
+	}
+
+	if filled.HealthCheckInterval <= 0 {
+		filled.HealthCheckInterval = defaultHealthCheckInterval
+	}
+	if filled.MaxBlockLag == 0 {
+		filled.MaxBlockLag = defaultMaxBlockLag
+	}
+	if filled.MaxLatency <= 0 {
+		filled.MaxLatency = defaultMaxLatency
+	}
+	if filled.MaxErrorRate <= 0 {
+		filled.MaxErrorRate = defaultMaxErrorRate
+	}
+	if filled.HttpClient == nil {
+		filled.HttpClient = http.DefaultClient
+	}
+
+	return filled
+}
+
+// Create an RPC client that sends its requests through the transport.
+//
+// returns: the RPC client, which can be used to create the SDK with NewWeb3sdksSDKFromRpcClient
+func (transport *FailoverTransport) Dial() (*rpc.Client, error) {
+	client := &http.Client{
+		Transport: transport,
+		Timeout:   transport.options.HttpClient.Timeout,
+	}
+
+	return rpc.DialHTTPWithClient(transport.endpoints[0].url, client)
+}
+
+// Get the health of every endpoint, as of the last health check and requests.
+//
+// returns: the health of the endpoints, in the order they were given
+func (transport *FailoverTransport) GetEndpointHealth() []EndpointHealth {
+	transport.mu.Lock()
+	defer transport.mu.Unlock()
+
+	best := transport.getBestBlockNumber()
+	health := []EndpointHealth{}
+	for i, endpoint := range transport.endpoints {
+		health = append(health, EndpointHealth{
+			Url:         endpoint.url,
+			BlockNumber: endpoint.blockNumber,
+			Latency:     endpoint.latency,
+			ErrorRate:   endpoint.errorRate,
+			Healthy:     transport.isHealthy(endpoint, best),
+			Pinned:      i == transport.pinned,
+			LastChecked: endpoint.lastChecked,
+		})
+	}
+
+	return health
+}
+
+// Check the health of every endpoint now, by fetching their latest block number.
+func (transport *FailoverTransport) CheckHealth(ctx context.Context) {
+	wg := sync.WaitGroup{}
+	for _, endpoint := range transport.endpoints {
+		wg.Add(1)
+		go func(endpoint *rpcEndpoint) {
+			defer wg.Done()
+			transport.checkEndpoint(ctx, endpoint)
+		}(endpoint)
+	}
+	wg.Wait()
+
+	transport.mu.Lock()
+	defer transport.mu.Unlock()
+	transport.lastCheck = time.Now()
+}
+
+func (transport *FailoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport.checkHealthInBackground()
+
+	body := []byte{}
+	if req.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+	}
+
+	if isPinnedRpcRequest(body) {
+		return transport.sendPinned(req, body)
+	}
+
+	// The response of the last endpoint is returned if every endpoint fails, so the status of a
+	// server error or rate limiting is passed on
+	var lastRes *http.Response
+	var lastErr error
+	for _, endpoint := range transport.rankEndpoints() {
+		res, err := transport.send(req, body, endpoint)
+		if err == nil {
+			closeResponse(lastRes)
+			return res, nil
+		}
+
+		closeResponse(lastRes)
+		lastRes, lastErr = res, err
+		if req.Context().Err() != nil {
+			break
+		}
+	}
+
+	if lastRes != nil {
+		return lastRes, nil
+	}
+
+	return nil, lastErr
+}
+
+// Send a request that must go to the pinned endpoint, only failing over if the connection to the
+// endpoint could not be established, in which case the request was never received
+func (transport *FailoverTransport) sendPinned(req *http.Request, body []byte) (*http.Response, error) {
+	var lastErr error
+	for attempts := 0; attempts < len(transport.endpoints); attempts++ {
+		endpoint := transport.getPinnedEndpoint()
+
+		res, err := transport.send(req, body, endpoint)
+		if err == nil || res != nil {
+			return res, nil
+		}
+
+		lastErr = err
+		if !isDialError(err) {
+			break
+		}
+
+		transport.repin(endpoint)
+	}
+
+	return nil, lastErr
+}
+
+// Send the request to an endpoint, recording its latency and whether it failed. Server errors and
+// rate limiting count as failures, in which case both the response and an error are returned.
+func (transport *FailoverTransport) send(req *http.Request, body []byte, endpoint *rpcEndpoint) (*http.Response, error) {
+	endpointReq := req.Clone(req.Context())
+	endpointReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+	endpointReq.ContentLength = int64(len(body))
+	endpointReq.Host = ""
+
+	var err error
+	if endpointReq.URL, err = endpointReq.URL.Parse(endpoint.url); err != nil {
+		return nil, err
+	}
+
+	baseTransport := transport.options.HttpClient.Transport
+	if baseTransport == nil {
+		baseTransport = http.DefaultTransport
+	}
+
+	start := time.Now()
+	res, err := baseTransport.RoundTrip(endpointReq)
+	if err == nil && (res.StatusCode >= http.StatusInternalServerError || res.StatusCode == http.StatusTooManyRequests) {
+		err = fmt.Errorf("RPC endpoint %s responded with status %s", endpoint.url, res.Status)
+	}
+
+	transport.record(endpoint, time.Since(start), err == nil)
+
+	return res, err
+}
+
+// Get the endpoints in the order they should be tried for a read: healthy endpoints from the
+// fastest to the slowest, followed by the unhealthy ones from the least to the most failing
+func (transport *FailoverTransport) rankEndpoints() []*rpcEndpoint {
+	transport.mu.Lock()
+	defer transport.mu.Unlock()
+
+	best := transport.getBestBlockNumber()
+	healthy := []*rpcEndpoint{}
+	unhealthy := []*rpcEndpoint{}
+	for _, endpoint := range transport.endpoints {
+		if transport.isHealthy(endpoint, best) {
+			healthy = append(healthy, endpoint)
+		} else {
+			unhealthy = append(unhealthy, endpoint)
+		}
+	}
+
+	sort.SliceStable(healthy, func(i, j int) bool {
+		return healthy[i].latency < healthy[j].latency
+	})
+	sort.SliceStable(unhealthy, func(i, j int) bool {
+		return unhealthy[i].errorRate < unhealthy[j].errorRate
+	})
+
+	return append(healthy, unhealthy...)
+}
+
+// Get the pinned endpoint, moving the pin to the healthiest endpoint if it became unhealthy
+func (transport *FailoverTransport) getPinnedEndpoint() *rpcEndpoint {
+	transport.mu.Lock()
+	pinned := transport.endpoints[transport.pinned]
+	healthy := transport.isHealthy(pinned, transport.getBestBlockNumber())
+	transport.mu.Unlock()
+
+	if !healthy {
+		transport.repin(pinned)
+	}
+
+	transport.mu.Lock()
+	defer transport.mu.Unlock()
+	return transport.endpoints[transport.pinned]
+}
+
+// Move the pin away from a failing endpoint to the healthiest other endpoint
+func (transport *FailoverTransport) repin(failing *rpcEndpoint) {
+	ranked := transport.rankEndpoints()
+
+	transport.mu.Lock()
+	defer transport.mu.Unlock()
+
+	// Another request might have moved the pin already
+	if transport.endpoints[transport.pinned] != failing {
+		return
+	}
+
+	for _, endpoint := range ranked {
+		if endpoint == failing {
+			continue
+		}
+
+		for i := range transport.endpoints {
+			if transport.endpoints[i] == endpoint {
+				transport.pinned = i
+				return
+			}
+		}
+	}
+}
+
+func (transport *FailoverTransport) record(endpoint *rpcEndpoint, latency time.Duration, succeeded bool) {
+	transport.mu.Lock()
+	defer transport.mu.Unlock()
+
+	failed := 1.0
+	if succeeded {
+		failed = 0
+		if endpoint.latency == 0 {
+			endpoint.latency = latency
+		} else {
+			endpoint.latency = time.Duration((1-endpointSampleWeight)*float64(endpoint.latency) + endpointSampleWeight*float64(latency))
+		}
+	}
+	endpoint.errorRate = (1-endpointSampleWeight)*endpoint.errorRate + endpointSampleWeight*failed
+}
+
+func (transport *FailoverTransport) checkHealthInBackground() {
+	transport.mu.Lock()
+	defer transport.mu.Unlock()
+
+	if transport.checking || time.Since(transport.lastCheck) < transport.options.HealthCheckInterval {
+		return
+	}
+
+	transport.checking = true
+	go func() {
+		transport.CheckHealth(context.Background())
+
+		transport.mu.Lock()
+		defer transport.mu.Unlock()
+		transport.checking = false
+	}()
+}
+
+func (transport *FailoverTransport) checkEndpoint(ctx context.Context, endpoint *rpcEndpoint) {
+	ctx, cancel := context.WithTimeout(ctx, transport.options.MaxLatency)
+	defer cancel()
+
+	body := []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`)
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint.url, bytes.NewReader(body))
+	if err != nil {
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	blockNumber, err := transport.fetchBlockNumber(req, body, endpoint)
+
+	transport.mu.Lock()
+	defer transport.mu.Unlock()
+	endpoint.lastChecked = time.Now()
+	if err == nil {
+		endpoint.blockNumber = blockNumber
+	}
+}
+
+func (transport *FailoverTransport) fetchBlockNumber(req *http.Request, body []byte, endpoint *rpcEndpoint) (uint64, error) {
+	res, err := transport.send(req, body, endpoint)
+	if err != nil {
+		closeResponse(res)
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	result := struct {
+		Result hexutil.Uint64 `json:"result"`
+	}{}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return 0, err
+	}
+
+	return uint64(result.Result), nil
+}
+
+func (transport *FailoverTransport) isHealthy(endpoint *rpcEndpoint, bestBlockNumber uint64) bool {
+	if endpoint.errorRate > transport.options.MaxErrorRate {
+		return false
+	}
+	if endpoint.latency > transport.options.MaxLatency {
+		return false
+	}
+	// The block number of an endpoint is unknown until its first health check
+	if endpoint.lastChecked.IsZero() {
+		return true
+	}
+
+	return bestBlockNumber <= endpoint.blockNumber+transport.options.MaxBlockLag
+}
+
+func (transport *FailoverTransport) getBestBlockNumber() uint64 {
+	best := uint64(0)
+	for _, endpoint := range transport.endpoints {
+		if endpoint.blockNumber > best {
+			best = endpoint.blockNumber
+		}
+	}
+
+	return best
+}
+
+// Check whether a JSON-RPC request or batch contains a method pinned to a single endpoint
+func isPinnedRpcRequest(body []byte) bool {
+	for _, method := range getRpcMethods(body) {
+		if pinnedRpcMethods[method] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Get the methods called by a JSON-RPC request or batch
+func getRpcMethods(body []byte) []string {
+	type rpcRequest struct {
+		Method string `json:"method"`
+	}
+
+	requests := []rpcRequest{}
+	if err := json.Unmarshal(body, &requests); err != nil {
+		request := rpcRequest{}
+		if err := json.Unmarshal(body, &request); err != nil {
+			return nil
+		}
+		requests = append(requests, request)
+	}
+
+	methods := []string{}
+	for _, request := range requests {
+		methods = append(methods, request.Method)
+	}
+
+	return methods
+}
+
+// Check whether a request failed because the connection couldn't be established, in which case
+// the request was never received by the server
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+func closeResponse(res *http.Response) {
+	if res != nil {
+		res.Body.Close()
+	}
+}
@@ -0,0 +1,144 @@
+package web3sdks
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+// Mock endpoint of a chain at the given block number
+func newFailoverMockRpcServer(blockNumber string) *mockRpcServer {
+	server := newMockRpcServer()
+	server.handle("eth_blockNumber", func(params []json.RawMessage) (interface{}, error) {
+		return blockNumber, nil
+	})
+	server.handle("eth_chainId", func(params []json.RawMessage) (interface{}, error) {
+		return "0x1", nil
+	})
+	server.handle("eth_sendRawTransaction", func(params []json.RawMessage) (interface{}, error) {
+		return "0x0000000000000000000000000000000000000000000000000000000000000000", nil
+	})
+
+	return server
+}
+
+func newTestFailoverClient(t *testing.T, rpcUrls ...string) (*FailoverTransport, *ethclient.Client) {
+	transport, err := NewFailoverTransport(rpcUrls, &FailoverOptions{MaxBlockLag: 2})
+	assert.Nil(t, err)
+
+	client, err := transport.Dial()
+	assert.Nil(t, err)
+
+	return transport, ethclient.NewClient(client)
+}
+
+func TestFailoverRoutesReadsToHealthiestEndpoint(t *testing.T) {
+	lagging := newFailoverMockRpcServer("0x5")
+	defer lagging.Close()
+	synced := newFailoverMockRpcServer("0x10")
+	defer synced.Close()
+
+	transport, client := newTestFailoverClient(t, lagging.URL, synced.URL)
+	transport.CheckHealth(context.Background())
+
+	health := transport.GetEndpointHealth()
+	assert.False(t, health[0].Healthy)
+	assert.True(t, health[1].Healthy)
+	assert.Equal(t, uint64(0x10), health[1].BlockNumber)
+
+	_, err := client.ChainID(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 0, lagging.callCount("eth_chainId"))
+	assert.Equal(t, 1, synced.callCount("eth_chainId"))
+}
+
+func TestFailoverRetriesReadsOnAnotherEndpoint(t *testing.T) {
+	down := newFailoverMockRpcServer("0x10")
+	down.Close()
+	up := newFailoverMockRpcServer("0x10")
+	defer up.Close()
+
+	transport, client := newTestFailoverClient(t, down.URL, up.URL)
+
+	chainId, err := client.ChainID(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), chainId.Int64())
+	assert.Equal(t, 1, up.callCount("eth_chainId"))
+	assert.Greater(t, transport.GetEndpointHealth()[0].ErrorRate, 0.0)
+}
+
+func TestFailoverPinsWrites(t *testing.T) {
+	first := newFailoverMockRpcServer("0x10")
+	defer first.Close()
+	second := newFailoverMockRpcServer("0x10")
+	defer second.Close()
+
+	_, client := newTestFailoverClient(t, first.URL, second.URL)
+
+	tx := newMockSignedTx(t)
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, client.SendTransaction(context.Background(), tx))
+	}
+
+	assert.Equal(t, 3, first.callCount("eth_sendRawTransaction"))
+	assert.Equal(t, 0, second.callCount("eth_sendRawTransaction"))
+}
+
+func TestFailoverNeverResendsFailedWrites(t *testing.T) {
+	var sent int32
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if body, _ := ioutil.ReadAll(r.Body); isPinnedRpcRequest(body) {
+			atomic.AddInt32(&sent, 1)
+		}
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer failing.Close()
+	healthy := newFailoverMockRpcServer("0x10")
+	defer healthy.Close()
+
+	_, client := newTestFailoverClient(t, failing.URL, healthy.URL)
+
+	err := client.SendTransaction(context.Background(), newMockSignedTx(t))
+	assert.NotNil(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&sent))
+	assert.Equal(t, 0, healthy.callCount("eth_sendRawTransaction"))
+}
+
+func TestFailoverMovesPinWhenEndpointIsDown(t *testing.T) {
+	down := newFailoverMockRpcServer("0x10")
+	down.Close()
+	up := newFailoverMockRpcServer("0x10")
+	defer up.Close()
+
+	transport, client := newTestFailoverClient(t, down.URL, up.URL)
+
+	// The connection to the pinned endpoint can't be established, so the transaction was never sent
+	assert.Nil(t, client.SendTransaction(context.Background(), newMockSignedTx(t)))
+	assert.Equal(t, 1, up.callCount("eth_sendRawTransaction"))
+	assert.True(t, transport.GetEndpointHealth()[1].Pinned)
+}
+
+func TestFailoverOptionsRejectedWithProvider(t *testing.T) {
+	client, err := rpc.Dial("http://localhost:8545")
+	assert.Nil(t, err)
+
+	_, err = NewWeb3sdksSDKFromRpcClient(client, &SDKOptions{RpcUrls: []string{"http://localhost:8546"}})
+	assert.NotNil(t, err)
+	_, err = NewWeb3sdksSDKFromProvider(ethclient.NewClient(client), &SDKOptions{Retry: &RetryOptions{}})
+	assert.NotNil(t, err)
+	_, err = NewWeb3sdksSDKFromProvider(ethclient.NewClient(client), &SDKOptions{Failover: &FailoverOptions{}})
+	assert.NotNil(t, err)
+}
+
+func TestFailoverOptionsRequireRpcUrls(t *testing.T) {
+	_, err := NewWeb3sdksSDK("http://localhost:8545", &SDKOptions{Failover: &FailoverOptions{}})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,285 @@
+package web3sdks
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultRetryMaxRetries     = 3
+	defaultRetryInitialBackoff = time.Millisecond * 250
+	defaultRetryMaxBackoff     = time.Second * 10
+)
+
+// Methods that must not be retried unless the server is known not to have processed them, since
+// sending them twice has side effects
+var unsafeRpcMethods = map[string]bool{
+	"eth_sendRawTransaction": true,
+	"eth_sendTransaction":    true,
+}
+
+// Options controlling the rate limiting and retries of the requests to the RPC endpoints, set with
+// the Retry field of the SDKOptions.
+type RetryOptions struct {
+	// Maximum number of requests per second, including retries. Unlimited if 0
+	RequestsPerSecond float64
+	// Maximum number of requests in flight at the same time. Unlimited if 0
+	MaxConcurrency int
+	// The policy of methods without an entry in MethodPolicies, defaults to 3 retries with a backoff
+	// from 250 milliseconds up to 10 seconds
+	DefaultPolicy *RetryPolicy
+	// Retry policies by JSON-RPC method, like "eth_call" or "eth_getLogs"
+	MethodPolicies map[string]*RetryPolicy
+	// The transport the requests are sent through. Defaults to the failover transport when the SDK
+	// is created with several RPC URLs, and to http.DefaultTransport otherwise
+	Transport http.RoundTripper
+}
+
+// How a JSON-RPC method is retried. The backoff doubles after every attempt up to MaxBackoff, and
+// a random jitter of up to half the backoff is subtracted from it.
+type RetryPolicy struct {
+	// Maximum number of retries after the first attempt, 0 disables retries
+	MaxRetries int
+	// Backoff before the first retry, defaults to 250 milliseconds
+	InitialBackoff time.Duration
+	// Maximum backoff between retries, defaults to 10 seconds
+	MaxBackoff time.Duration
+}
+
+// An HTTP transport for JSON-RPC clients that rate limits requests and retries the ones that fail
+// with a retryable error: rate limiting (429), server errors (500, 502, 503 and 504) and network
+// errors.
+//
+// Transactions are never blindly sent again. They are only retried when the server is known not to
+// have processed them, which is the case when the connection couldn't be established or the request
+// was rate limited. Batches containing a transaction follow the same rule.
+//
+// Example
+//
+//	transport := web3sdks.NewRetryTransport(&web3sdks.RetryOptions{
+//		RequestsPerSecond: 25,
+//		MaxConcurrency:    10,
+//		MethodPolicies: map[string]*web3sdks.RetryPolicy{
+//			"eth_getLogs": {MaxRetries: 5, InitialBackoff: time.Second},
+//		},
+//	})
+//	client, err := rpc.DialHTTPWithClient(rpcUrl, &http.Client{Transport: transport})
+//	sdk, err := web3sdks.NewWeb3sdksSDKFromRpcClient(client, nil)
+type RetryTransport struct {
+	options   RetryOptions
+	limiter   *rateLimiter
+	semaphore chan struct{}
+}
+
+// Create a transport that rate limits and retries requests.
+//
+// options: the retry options, or nil to use the defaults
+//
+// returns: the retry transport
+func NewRetryTransport(options *RetryOptions) *RetryTransport {
+	transport := &RetryTransport{}
+	if options != nil {
+		transport.options = *options
+	}
+
+	if transport.options.DefaultPolicy == nil {
+		transport.options.DefaultPolicy = &RetryPolicy{MaxRetries: defaultRetryMaxRetries}
+	}
+	if transport.options.Transport == nil {
+		transport.options.Transport = http.DefaultTransport
+	}
+	if transport.options.RequestsPerSecond > 0 {
+		transport.limiter = &rateLimiter{interval: time.Duration(float64(time.Second) / transport.options.RequestsPerSecond)}
+	}
+	if transport.options.MaxConcurrency > 0 {
+		transport.semaphore = make(chan struct{}, transport.options.MaxConcurrency)
+	}
+
+	return transport
+}
+
+func (transport *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body := []byte{}
+	if req.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+	}
+
+	methods := getRpcMethods(body)
+	policy := transport.getPolicy(methods)
+	unsafe := false
+	for _, method := range methods {
+		unsafe = unsafe || unsafeRpcMethods[method]
+	}
+
+	ctx := req.Context()
+	for attempt := 0; ; attempt++ {
+		res, err := transport.send(req, body)
+
+		if attempt >= policy.MaxRetries || !isRetryable(res, err, unsafe) || ctx.Err() != nil {
+			return res, err
+		}
+
+		backoff := policy.getBackoff(attempt)
+		if retryAfter := getRetryAfter(res); retryAfter > backoff {
+			backoff = retryAfter
+		}
+		closeResponse(res)
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (transport *RetryTransport) send(req *http.Request, body []byte) (*http.Response, error) {
+	ctx := req.Context()
+	if transport.limiter != nil {
+		if err := transport.limiter.wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if transport.semaphore != nil {
+		select {
+		case transport.semaphore <- struct{}{}:
+			defer func() { <-transport.semaphore }()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	attemptReq := req.Clone(ctx)
+	attemptReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+	attemptReq.ContentLength = int64(len(body))
+
+	res, err := transport.options.Transport.RoundTrip(attemptReq)
+	if err != nil || transport.semaphore == nil {
+		return res, err
+	}
+
+	// Hold the concurrency slot until the response has been read
+	resBody, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(resBody))
+
+	return res, nil
+}
+
+// Get the policy of a request, which for a batch is the strictest policy of its methods
+func (transport *RetryTransport) getPolicy(methods []string) *RetryPolicy {
+	var policy *RetryPolicy
+	for _, method := range methods {
+		methodPolicy, ok := transport.options.MethodPolicies[method]
+		if !ok {
+			methodPolicy = transport.options.DefaultPolicy
+		}
+
+		if policy == nil || methodPolicy.MaxRetries < policy.MaxRetries {
+			policy = methodPolicy
+		}
+	}
+
+	if policy == nil {
+		return transport.options.DefaultPolicy
+	}
+
+	return policy
+}
+
+// Get the backoff before the given retry, with a random jitter of up to half the backoff
+func (policy *RetryPolicy) getBackoff(attempt int) time.Duration {
+	initialBackoff := policy.InitialBackoff
+	if initialBackoff <= 0 {
+		initialBackoff = defaultRetryInitialBackoff
+	}
+	maxBackoff := policy.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = defaultRetryMaxBackoff
+	}
+
+	backoff := initialBackoff
+	for i := 0; i < attempt && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+
+	return backoff - time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
+
+// Check whether a failed request can be retried. Unsafe requests are only retried if the server
+// didn't process them.
+func isRetryable(res *http.Response, err error, unsafe bool) bool {
+	if err != nil {
+		return isDialError(err) || !unsafe
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return !unsafe
+	}
+
+	return false
+}
+
+// Get the delay requested by the Retry-After header of a response, in seconds
+func getRetryAfter(res *http.Response) time.Duration {
+	if res == nil {
+		return 0
+	}
+
+	seconds, err := strconv.Atoi(res.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
+// Spaces requests evenly so that at most one request starts every interval
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func (limiter *rateLimiter) wait(ctx context.Context) error {
+	limiter.mu.Lock()
+	now := time.Now()
+	if limiter.next.Before(now) {
+		limiter.next = now
+	}
+	start := limiter.next
+	limiter.next = limiter.next.Add(limiter.interval)
+	limiter.mu.Unlock()
+
+	delay := time.Until(start)
+	if delay <= 0 {
+		return nil
+	}
+
+	select {
+	case <-time.After(delay):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
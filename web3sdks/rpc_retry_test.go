@@ -0,0 +1,162 @@
+package web3sdks
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+// Server failing the first requests of every method with the given status before succeeding
+type flakyRpcServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	failures int
+	status   int
+	calls    map[string]int
+	inFlight int
+	peak     int
+}
+
+func newFlakyRpcServer(failures int, status int, delay time.Duration) *flakyRpcServer {
+	server := &flakyRpcServer{failures: failures, status: status, calls: map[string]int{}}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		method := getRpcMethods(body)[0]
+
+		server.mu.Lock()
+		server.calls[method] += 1
+		calls := server.calls[method]
+		server.inFlight += 1
+		if server.inFlight > server.peak {
+			server.peak = server.inFlight
+		}
+		server.mu.Unlock()
+
+		time.Sleep(delay)
+
+		server.mu.Lock()
+		server.inFlight -= 1
+		server.mu.Unlock()
+
+		if calls <= server.failures {
+			w.WriteHeader(server.status)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch method {
+		case "eth_sendRawTransaction":
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x0000000000000000000000000000000000000000000000000000000000000000"}`))
+		default:
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+		}
+	}))
+
+	return server
+}
+
+func (server *flakyRpcServer) callCount(method string) int {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	return server.calls[method]
+}
+
+func newTestRetryClient(t *testing.T, url string, options *RetryOptions) *ethclient.Client {
+	if options.DefaultPolicy == nil {
+		options.DefaultPolicy = &RetryPolicy{MaxRetries: 3, InitialBackoff: time.Millisecond}
+	}
+
+	client, err := rpc.DialHTTPWithClient(url, &http.Client{Transport: NewRetryTransport(options)})
+	assert.Nil(t, err)
+
+	return ethclient.NewClient(client)
+}
+
+func TestRetryTransportRetriesRateLimitedReads(t *testing.T) {
+	server := newFlakyRpcServer(2, http.StatusTooManyRequests, 0)
+	defer server.Close()
+
+	client := newTestRetryClient(t, server.URL, &RetryOptions{})
+
+	chainId, err := client.ChainID(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), chainId.Int64())
+	assert.Equal(t, 3, server.callCount("eth_chainId"))
+}
+
+func TestRetryTransportGivesUpAfterMaxRetries(t *testing.T) {
+	server := newFlakyRpcServer(10, http.StatusServiceUnavailable, 0)
+	defer server.Close()
+
+	client := newTestRetryClient(t, server.URL, &RetryOptions{})
+
+	_, err := client.ChainID(context.Background())
+	assert.NotNil(t, err)
+	assert.Equal(t, 4, server.callCount("eth_chainId"))
+}
+
+func TestRetryTransportMethodPolicies(t *testing.T) {
+	server := newFlakyRpcServer(1, http.StatusBadGateway, 0)
+	defer server.Close()
+
+	client := newTestRetryClient(t, server.URL, &RetryOptions{
+		MethodPolicies: map[string]*RetryPolicy{"eth_chainId": {MaxRetries: 0}},
+	})
+
+	_, err := client.ChainID(context.Background())
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, server.callCount("eth_chainId"))
+}
+
+func TestRetryTransportNeverResendsProcessedWrites(t *testing.T) {
+	server := newFlakyRpcServer(1, http.StatusBadGateway, 0)
+	defer server.Close()
+
+	client := newTestRetryClient(t, server.URL, &RetryOptions{})
+
+	err := client.SendTransaction(context.Background(), newMockSignedTx(t))
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, server.callCount("eth_sendRawTransaction"))
+}
+
+func TestRetryTransportRetriesRateLimitedWrites(t *testing.T) {
+	server := newFlakyRpcServer(1, http.StatusTooManyRequests, 0)
+	defer server.Close()
+
+	client := newTestRetryClient(t, server.URL, &RetryOptions{})
+
+	err := client.SendTransaction(context.Background(), newMockSignedTx(t))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, server.callCount("eth_sendRawTransaction"))
+}
+
+func TestRetryTransportRateLimits(t *testing.T) {
+	server := newFlakyRpcServer(0, http.StatusOK, time.Millisecond*20)
+	defer server.Close()
+
+	client := newTestRetryClient(t, server.URL, &RetryOptions{RequestsPerSecond: 50, MaxConcurrency: 2})
+
+	start := time.Now()
+	wg := sync.WaitGroup{}
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.ChainID(context.Background())
+			assert.Nil(t, err)
+		}()
+	}
+	wg.Wait()
+
+	// 6 requests at 50 per second take at least 100ms, and only 2 are ever in flight
+	assert.GreaterOrEqual(t, time.Since(start), time.Millisecond*100)
+	assert.LessOrEqual(t, server.peak, 2)
+}
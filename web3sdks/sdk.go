@@ -2,12 +2,14 @@ package web3sdks
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 )
 
 type Web3sdksSDK struct {
@@ -23,31 +25,89 @@ type Web3sdksSDK struct {
 //
 // rpcUrlOrName: the name of the chain to connection to (e.g. "rinkeby", "mumbai", "polygon", "mainnet", "fantom", "avalanche") or the RPC URL to connect to
 //
-// options: an SDKOptions instance to specify a private key and/or an IPFS gateway URL
+// options: an SDKOptions instance to specify a private key or signer and/or an IPFS gateway URL. Set
+// the RpcUrls option to fail over between several RPC URLs of the chain, and the Retry option to
+// rate limit and retry requests
 func NewWeb3sdksSDK(rpcUrlOrChainName string, options *SDKOptions) (*Web3sdksSDK, error) {
-	rpc, err := getDefaultRpcUrl(rpcUrlOrChainName)
+	rpcUrl, err := getDefaultRpcUrl(rpcUrlOrChainName)
 	if err != nil {
 		return nil, err
 	}
 
-	provider, err := ethclient.Dial(rpc)
+	if options != nil && (len(options.RpcUrls) > 0 || options.Failover != nil || options.Retry != nil) {
+		client, err := dialWithTransports(rpcUrl, options)
+		if err != nil {
+			return nil, err
+		}
+
+		return newWeb3sdksSDKFromRpcClient(client, options)
+	}
+
+	client, err := rpc.Dial(rpcUrl)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewWeb3sdksSDKFromProvider(provider, options)
+	return newWeb3sdksSDKFromRpcClient(client, options)
 }
 
+// Create a new instance of the Web3sdks SDK from a raw RPC client. Unlike
+// NewWeb3sdksSDKFromProvider, this enables the features that need direct access
+// to the RPC client, like the FeeHistoryStrategy.
+//
+// client: the RPC client to connect with
+//
+// options: an SDKOptions instance to specify a private key or signer and/or an IPFS gateway URL. The
+// RpcUrls, Failover and Retry options aren't supported, as the RPC client is already created
+func NewWeb3sdksSDKFromRpcClient(client *rpc.Client, options *SDKOptions) (*Web3sdksSDK, error) {
+	if err := checkNoTransportOptions(options); err != nil {
+		return nil, err
+	}
+
+	return newWeb3sdksSDKFromRpcClient(client, options)
+}
+
+// Create a new instance of the Web3sdks SDK from a provider.
+//
+// provider: the provider to connect with
+//
+// options: an SDKOptions instance to specify a private key or signer and/or an IPFS gateway URL. The
+// RpcUrls, Failover and Retry options aren't supported, as the provider is already created
 func NewWeb3sdksSDKFromProvider(provider *ethclient.Client, options *SDKOptions) (*Web3sdksSDK, error) {
+	if err := checkNoTransportOptions(options); err != nil {
+		return nil, err
+	}
+
+	return newWeb3sdksSDKFromProvider(provider, options)
+}
+
+func newWeb3sdksSDKFromRpcClient(client *rpc.Client, options *SDKOptions) (*Web3sdksSDK, error) {
+	sdk, err := newWeb3sdksSDKFromProvider(ethclient.NewClient(client), options)
+	if err != nil {
+		return nil, err
+	}
+
+	sdk.UpdateRpcClient(client)
+	return sdk, nil
+}
+
+func newWeb3sdksSDKFromProvider(provider *ethclient.Client, options *SDKOptions) (*Web3sdksSDK, error) {
 	// Define defaults for all the options
-	privateKey := ""
+	var signer Signer
 	gatewayUrl := defaultIpfsGatewayUrl
 	httpClient := http.DefaultClient
 
 	// Override defaults with the options that are defined
 	if options != nil {
-		if options.PrivateKey != "" {
-			privateKey = options.PrivateKey
+		if options.Signer != nil {
+			signer = options.Signer
+		} else if options.PrivateKey != "" {
+			privateKeySigner, err := NewPrivateKeySigner(options.PrivateKey)
+			if err != nil {
+				return nil, err
+			}
+
+			signer = privateKeySigner
 		}
 
 		if options.GatewayUrl != "" {
@@ -61,17 +121,28 @@ func NewWeb3sdksSDKFromProvider(provider *ethclient.Client, options *SDKOptions)
 
 	storage := newIpfsStorage(gatewayUrl, httpClient)
 
-	handler, err := NewProviderHandler(provider, privateKey)
-	if err != nil {
-		return nil, err
+	handler := NewProviderHandlerWithSigner(provider, signer)
+	if options != nil {
+		handler.SetTxWaitOptions(options.TxWait)
+		handler.SetFeeStrategy(options.FeeStrategy)
+		handler.SetTransactionType(options.TransactionType)
+		handler.SetCreateAccessList(options.CreateAccessList)
+		handler.SetReadBatchOptions(options.ReadBatch)
+		handler.SetFetchConcurrency(options.FetchConcurrency)
+
+		if options.Cache != nil {
+			cache := newReadCache(options.Cache, handler)
+			handler.cache = cache
+			storage.cache = cache
+		}
 	}
 
-	deployer, err := newContractDeployer(provider, privateKey, storage)
+	deployer, err := newContractDeployer(handler, storage)
 	if err != nil {
 		return nil, err
 	}
 
-	auth, err := newWalletAuthenticator(provider, privateKey)
+	auth, err := newWalletAuthenticator(provider, signer)
 	if err != nil {
 		return nil, err
 	}
@@ -95,7 +166,7 @@ func (sdk *Web3sdksSDK) GetNFTCollection(address string) (*NFTCollection, error)
 	return newNFTCollection(
 		sdk.GetProvider(),
 		common.HexToAddress(address),
-		sdk.GetRawPrivateKey(),
+		sdk.ProviderHandler,
 		&sdk.Storage,
 	)
 }
@@ -109,7 +180,7 @@ func (sdk *Web3sdksSDK) GetEdition(address string) (*Edition, error) {
 	return newEdition(
 		sdk.GetProvider(),
 		common.HexToAddress(address),
-		sdk.GetRawPrivateKey(),
+		sdk.ProviderHandler,
 		&sdk.Storage,
 	)
 }
@@ -125,7 +196,7 @@ func (sdk *Web3sdksSDK) GetToken(address string) (*Token, error) {
 	return newToken(
 		sdk.GetProvider(),
 		common.HexToAddress(address),
-		sdk.GetRawPrivateKey(),
+		sdk.ProviderHandler,
 		&sdk.Storage,
 	)
 }
@@ -139,7 +210,7 @@ func (sdk *Web3sdksSDK) GetNFTDrop(address string) (*NFTDrop, error) {
 	return newNFTDrop(
 		sdk.GetProvider(),
 		common.HexToAddress(address),
-		sdk.GetRawPrivateKey(),
+		sdk.ProviderHandler,
 		&sdk.Storage,
 	)
 }
@@ -153,7 +224,7 @@ func (sdk *Web3sdksSDK) GetEditionDrop(address string) (*EditionDrop, error) {
 	return newEditionDrop(
 		sdk.GetProvider(),
 		common.HexToAddress(address),
-		sdk.GetRawPrivateKey(),
+		sdk.ProviderHandler,
 		&sdk.Storage,
 	)
 }
@@ -167,7 +238,7 @@ func (sdk *Web3sdksSDK) GetMultiwrap(address string) (*Multiwrap, error) {
 	return newMultiwrap(
 		sdk.GetProvider(),
 		common.HexToAddress(address),
-		sdk.GetRawPrivateKey(),
+		sdk.ProviderHandler,
 		&sdk.Storage,
 	)
 }
@@ -181,7 +252,7 @@ func (sdk *Web3sdksSDK) GetMarketplace(address string) (*Marketplace, error) {
 	return newMarketplace(
 		sdk.GetProvider(),
 		common.HexToAddress(address),
-		sdk.GetRawPrivateKey(),
+		sdk.ProviderHandler,
 		&sdk.Storage,
 	)
 }
@@ -212,7 +283,7 @@ func (sdk *Web3sdksSDK) GetContractFromAbi(address string, abi string) (*SmartCo
 		sdk.GetProvider(),
 		common.HexToAddress(address),
 		abi,
-		sdk.GetRawPrivateKey(),
+		sdk.ProviderHandler,
 		&sdk.Storage,
 	)
 }
@@ -222,6 +293,53 @@ func defaultRpc(network string) (string, error) {
 	return fmt.Sprintf("https://%s.rpc.web3sdks.com/%s", network, defaultApiKey), nil
 }
 
+// The failover and retry transports are set up when dialing the RPC URL, so they can't be added to
+// an existing provider or RPC client
+func checkNoTransportOptions(options *SDKOptions) error {
+	if options != nil && (len(options.RpcUrls) > 0 || options.Failover != nil || options.Retry != nil) {
+		return errors.New("The RpcUrls, Failover and Retry options are only supported by NewWeb3sdksSDK, which dials the RPC URLs itself")
+	}
+
+	return nil
+}
+
+// Dial the RPC URL through the failover and retry transports enabled by the options
+func dialWithTransports(rpcUrl string, options *SDKOptions) (*rpc.Client, error) {
+	if !strings.HasPrefix(rpcUrl, "http") {
+		return nil, fmt.Errorf("Only HTTP RPC URLs support failover and retries, got %s", rpcUrl)
+	}
+
+	if options.Failover != nil && len(options.RpcUrls) == 0 {
+		return nil, errors.New("The Failover option needs the RpcUrls option to set the RPC URLs to fail over between")
+	}
+
+	var transport http.RoundTripper = http.DefaultTransport
+	if len(options.RpcUrls) > 0 {
+		rpcUrls := []string{rpcUrl}
+		for _, url := range options.RpcUrls {
+			if url != rpcUrl {
+				rpcUrls = append(rpcUrls, url)
+			}
+		}
+
+		failover, err := NewFailoverTransport(rpcUrls, options.Failover)
+		if err != nil {
+			return nil, err
+		}
+		transport = failover
+	}
+
+	if options.Retry != nil {
+		retryOptions := *options.Retry
+		if retryOptions.Transport == nil {
+			retryOptions.Transport = transport
+		}
+		transport = NewRetryTransport(&retryOptions)
+	}
+
+	return rpc.DialHTTPWithClient(rpcUrl, &http.Client{Transport: transport})
+}
+
 func getDefaultRpcUrl(rpcUrlorName string) (string, error) {
 	switch rpcUrlorName {
 	case "mumbai":
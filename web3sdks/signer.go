@@ -0,0 +1,318 @@
+package web3sdks
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	signerTypes "github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// A Signer holds the wallet used by the SDK to send transactions and sign messages. Instead of
+// passing a raw private key to the SDK, you can pass any implementation of this interface
+// through the Signer field of the SDKOptions.
+//
+// The SDK comes with signers for in-memory private keys, encrypted keystore files and remote
+// signing services, and you can implement the interface yourself to use any other key management.
+//
+// Example
+//
+//	keyJson, err := os.ReadFile("path/to/keystore.json")
+//	signer, err := web3sdks.NewKeystoreSigner(keyJson, os.Getenv("KEYSTORE_PASSWORD"))
+//
+//	sdk, err := web3sdks.NewWeb3sdksSDK("mumbai", &web3sdks.SDKOptions{
+//		Signer: signer,
+//	})
+type Signer interface {
+	// The address of the wallet that this signer signs for
+	GetAddress() common.Address
+
+	// Sign a transaction for the specified chain ID and return the signed transaction
+	SignTx(ctx context.Context, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error)
+
+	// Sign the keccak256 hash of the message. The message is signed as-is, so any EIP-191
+	// prefix has to already be included in the message.
+	SignMessage(ctx context.Context, message []byte) ([]byte, error)
+
+	// Sign the EIP-712 hash of the typed data.
+	SignTypedData(ctx context.Context, typedData signerTypes.TypedData) ([]byte, error)
+}
+
+// Signer backed by an ECDSA private key held in memory.
+type PrivateKeySigner struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+}
+
+// Create a new signer from a hex encoded private key.
+//
+// privateKey: the hex encoded private key, without the 0x prefix
+//
+// returns: a signer for the wallet of the private key
+func NewPrivateKeySigner(privateKey string) (*PrivateKeySigner, error) {
+	key, address, err := processPrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrivateKeySigner{key, address}, nil
+}
+
+// Create a new signer from an ECDSA private key.
+//
+// key: the private key to sign with
+//
+// returns: a signer for the wallet of the private key
+func NewPrivateKeySignerFromECDSA(key *ecdsa.PrivateKey) (*PrivateKeySigner, error) {
+	address, err := getPublicAddress(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrivateKeySigner{key, address}, nil
+}
+
+func (signer *PrivateKeySigner) GetAddress() common.Address {
+	return signer.address
+}
+
+func (signer *PrivateKeySigner) SignTx(ctx context.Context, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error) {
+	return types.SignTx(tx, types.LatestSignerForChainID(chainId), signer.key)
+}
+
+func (signer *PrivateKeySigner) SignMessage(ctx context.Context, message []byte) ([]byte, error) {
+	return crypto.Sign(crypto.Keccak256(message), signer.key)
+}
+
+func (signer *PrivateKeySigner) SignTypedData(ctx context.Context, typedData signerTypes.TypedData) ([]byte, error) {
+	hash, err := hashTypedData(typedData)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.Sign(hash, signer.key)
+}
+
+// Get the underlying private key of this signer.
+func (signer *PrivateKeySigner) GetPrivateKey() *ecdsa.PrivateKey {
+	return signer.key
+}
+
+// Create a new signer from a go-ethereum encrypted keystore JSON file. The key is decrypted once
+// and then held in memory.
+//
+// keyJson: the contents of the keystore file
+//
+// passphrase: the passphrase used to encrypt the keystore file
+//
+// returns: a signer for the wallet of the keystore file
+//
+// Example
+//
+//	keyJson, err := os.ReadFile("path/to/keystore.json")
+//	signer, err := web3sdks.NewKeystoreSigner(keyJson, os.Getenv("KEYSTORE_PASSWORD"))
+func NewKeystoreSigner(keyJson []byte, passphrase string) (*PrivateKeySigner, error) {
+	key, err := keystore.DecryptKey(keyJson, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrivateKeySigner{key.PrivateKey, key.Address}, nil
+}
+
+// Create a new signer from the path of a go-ethereum encrypted keystore JSON file.
+//
+// path: the path of the keystore file
+//
+// passphrase: the passphrase used to encrypt the keystore file
+//
+// returns: a signer for the wallet of the keystore file
+func NewKeystoreSignerFromFile(path string, passphrase string) (*PrivateKeySigner, error) {
+	keyJson, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewKeystoreSigner(keyJson, passphrase)
+}
+
+// Signer that delegates signing to a remote service over HTTP, so the private key never has to be
+// present in the process using the SDK.
+//
+// The remote service must accept JSON-RPC 2.0 requests at the configured URL for the following methods:
+//
+//	// Sign the transaction and return the RLP encoded signed transaction
+//	signer_signTransaction(address, rawUnsignedTx, chainId) -> "0x..."
+//
+//	// Sign a 32 byte hash and return the 65 byte [R || S || V] signature, with V as 0 or 1
+//	signer_signHash(address, hash) -> "0x..."
+type RemoteSigner struct {
+	url        string
+	address    common.Address
+	httpClient *http.Client
+	requestId  uint64
+}
+
+type remoteSignerRequest struct {
+	JsonRpc string        `json:"jsonrpc"`
+	Id      uint64        `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+type remoteSignerResponse struct {
+	Result hexutil.Bytes `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// Create a new signer that signs with a remote signing service.
+//
+// url: the URL of the remote signing service
+//
+// address: the address of the wallet that the remote service signs for
+//
+// httpClient: the HTTP client used to talk to the service, defaults to http.DefaultClient if nil
+//
+// returns: a signer that forwards all signing requests to the remote service
+func NewRemoteSigner(url string, address string, httpClient *http.Client) *RemoteSigner {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return &RemoteSigner{
+		url:        url,
+		address:    common.HexToAddress(address),
+		httpClient: httpClient,
+	}
+}
+
+func (signer *RemoteSigner) GetAddress() common.Address {
+	return signer.address
+}
+
+func (signer *RemoteSigner) SignTx(ctx context.Context, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error) {
+	rawTx, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := signer.call(ctx, "signer_signTransaction", signer.address, hexutil.Bytes(rawTx), (*hexutil.Big)(chainId))
+	if err != nil {
+		return nil, err
+	}
+
+	signedTx := new(types.Transaction)
+	if err := signedTx.UnmarshalBinary(result); err != nil {
+		return nil, err
+	}
+
+	// Make sure the remote service didn't sign with a different wallet
+	sender, err := types.Sender(types.LatestSignerForChainID(chainId), signedTx)
+	if err != nil {
+		return nil, err
+	}
+	if sender != signer.address {
+		return nil, fmt.Errorf("Remote signer signed transaction with address %s, expected %s", sender.String(), signer.address.String())
+	}
+
+	return signedTx, nil
+}
+
+func (signer *RemoteSigner) SignMessage(ctx context.Context, message []byte) ([]byte, error) {
+	return signer.signHash(ctx, crypto.Keccak256(message))
+}
+
+func (signer *RemoteSigner) SignTypedData(ctx context.Context, typedData signerTypes.TypedData) ([]byte, error) {
+	hash, err := hashTypedData(typedData)
+	if err != nil {
+		return nil, err
+	}
+
+	return signer.signHash(ctx, hash)
+}
+
+func (signer *RemoteSigner) signHash(ctx context.Context, hash []byte) ([]byte, error) {
+	signature, err := signer.call(ctx, "signer_signHash", signer.address, hexutil.Bytes(hash))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(signature) != 65 {
+		return nil, fmt.Errorf("Remote signer returned signature of invalid length %d", len(signature))
+	}
+
+	return signature, nil
+}
+
+func (signer *RemoteSigner) call(ctx context.Context, method string, params ...interface{}) ([]byte, error) {
+	body, err := json.Marshal(&remoteSignerRequest{
+		JsonRpc: "2.0",
+		Id:      atomic.AddUint64(&signer.requestId, 1),
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, signer.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := signer.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Remote signer request '%s' failed with status code %d", method, resp.StatusCode)
+	}
+
+	result := &remoteSignerResponse{}
+	if err := json.Unmarshal(respBody, result); err != nil {
+		return nil, &UnmarshalError{Body: string(respBody), TypeName: "remote signer response", UnderlyingError: err}
+	}
+
+	if result.Error != nil {
+		return nil, fmt.Errorf("Remote signer request '%s' failed: %s", method, result.Error.Message)
+	}
+
+	return result.Result, nil
+}
+
+func hashTypedData(typedData signerTypes.TypedData) ([]byte, error) {
+	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return nil, err
+	}
+
+	typedDataHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	rawData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator), string(typedDataHash)))
+	return crypto.Keccak256(rawData), nil
+}
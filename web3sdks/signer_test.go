@@ -0,0 +1,124 @@
+package web3sdks
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRemoteSignerServer(signer *PrivateKeySigner) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := struct {
+			Id     uint64            `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}{}
+		json.NewDecoder(r.Body).Decode(&request)
+
+		var result hexutil.Bytes
+		switch request.Method {
+		case "signer_signTransaction":
+			var rawTx hexutil.Bytes
+			var chainId hexutil.Big
+			json.Unmarshal(request.Params[1], &rawTx)
+			json.Unmarshal(request.Params[2], &chainId)
+
+			tx := new(types.Transaction)
+			tx.UnmarshalBinary(rawTx)
+			signedTx, _ := signer.SignTx(r.Context(), tx, (*big.Int)(&chainId))
+			result, _ = signedTx.MarshalBinary()
+		case "signer_signHash":
+			var hash hexutil.Bytes
+			json.Unmarshal(request.Params[1], &hash)
+			result, _ = crypto.Sign(hash, signer.GetPrivateKey())
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      request.Id,
+			"result":  result,
+		})
+	}))
+}
+
+func TestPrivateKeySigner(t *testing.T) {
+	signer, err := NewPrivateKeySigner(adminPrivateKey)
+	assert.Nil(t, err)
+	assert.Equal(t, adminWallet, signer.GetAddress().String())
+
+	message := []byte("hello")
+	signature, err := signer.SignMessage(context.Background(), message)
+	assert.Nil(t, err)
+
+	publicKey, err := crypto.SigToPub(crypto.Keccak256(message), signature)
+	assert.Nil(t, err)
+	assert.Equal(t, adminWallet, crypto.PubkeyToAddress(*publicKey).String())
+}
+
+func TestKeystoreSigner(t *testing.T) {
+	privateKey, _ := crypto.HexToECDSA(adminPrivateKey)
+	key := &keystore.Key{
+		Id:         uuid.New(),
+		Address:    crypto.PubkeyToAddress(privateKey.PublicKey),
+		PrivateKey: privateKey,
+	}
+	keyJson, err := keystore.EncryptKey(key, "password", keystore.LightScryptN, keystore.LightScryptP)
+	assert.Nil(t, err)
+
+	_, err = NewKeystoreSigner(keyJson, "wrong password")
+	assert.NotNil(t, err)
+
+	signer, err := NewKeystoreSigner(keyJson, "password")
+	assert.Nil(t, err)
+	assert.Equal(t, adminWallet, signer.GetAddress().String())
+}
+
+func TestRemoteSigner(t *testing.T) {
+	localSigner, _ := NewPrivateKeySigner(adminPrivateKey)
+	server := newTestRemoteSignerServer(localSigner)
+	defer server.Close()
+
+	signer := NewRemoteSigner(server.URL, adminWallet, nil)
+	chainId := big.NewInt(1)
+	to := common.HexToAddress(secondaryWallet)
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainId,
+		Nonce:     1,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(2),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(1),
+	})
+
+	signedTx, err := signer.SignTx(context.Background(), tx, chainId)
+	assert.Nil(t, err)
+
+	sender, err := types.Sender(types.LatestSignerForChainID(chainId), signedTx)
+	assert.Nil(t, err)
+	assert.Equal(t, adminWallet, sender.String())
+
+	message := []byte("hello")
+	signature, err := signer.SignMessage(context.Background(), message)
+	assert.Nil(t, err)
+
+	publicKey, err := crypto.SigToPub(crypto.Keccak256(message), signature)
+	assert.Nil(t, err)
+	assert.Equal(t, adminWallet, crypto.PubkeyToAddress(*publicKey).String())
+
+	// A remote signer for a different wallet should reject the signed transaction
+	otherSigner := NewRemoteSigner(server.URL, secondaryWallet, nil)
+	_, err = otherSigner.SignTx(context.Background(), tx, chainId)
+	assert.NotNil(t, err)
+}
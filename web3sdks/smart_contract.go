@@ -2,9 +2,6 @@ package web3sdks
 
 import (
 	"context"
-	"fmt"
-	"math/big"
-	"reflect"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -81,9 +78,8 @@ type SmartContract struct {
 	Events   *ContractEvents
 }
 
-func newSmartContract(provider *ethclient.Client, address common.Address, contractAbi string, privateKey string, storage storage) (*SmartContract, error) {
-	helper, err := newContractHelper(address, provider, privateKey)
-	fmt.Println(privateKey)
+func newSmartContract(provider *ethclient.Client, address common.Address, contractAbi string, handler *ProviderHandler, storage storage) (*SmartContract, error) {
+	helper, err := newContractHelper(address, handler)
 	if err != nil {
 		return nil, err
 	}
@@ -93,7 +89,8 @@ func newSmartContract(provider *ethclient.Client, address common.Address, contra
 		return nil, err
 	}
 
-	boundContract := bind.NewBoundContract(address, parsedAbi, provider, provider, provider)
+	backend := handler.getContractBackend(&parsedAbi)
+	boundContract := bind.NewBoundContract(address, parsedAbi, backend, backend, backend)
 
 	encoder, err := newContractEncoder(contractAbi, helper)
 	if err != nil {
@@ -131,55 +128,9 @@ func newSmartContract(provider *ethclient.Client, address common.Address, contra
 //	// You can also make a transaction to your contract with the call method
 //	tx, err := contract.Call(context.Background(), "mintTo", "{{wallet_address}}", "ipfs://...")
 func (c *SmartContract) Call(ctx context.Context, method string, args ...interface{}) (interface{}, error) {
-	abiMethod, exist := c.abi.Methods[method]
-	if !exist {
-		return nil, fmt.Errorf("function '%s' not found in contract '%s'", method, c.Helper.getAddress().String())
-	}
-
-	if len(abiMethod.Inputs) != len(args) {
-		return nil, fmt.Errorf(
-			"function '%s' requires %d arguments, but %d arguments were provided.\nExpected function signature '%s'",
-			method,
-			len(abiMethod.Inputs),
-			len(args),
-			abiMethod.Sig,
-		)
-	}
-
-	// Validate argument input types and convert to proper input types for contract
-	// So we can allow users to pass in string intead of address, int instead of big, etc.
-	typedArgs := []interface{}{}
-	for i, arg := range args {
-		input := abiMethod.Inputs[i]
-		inputType := fmt.Sprint(input.Type)
-
-		if inputType == "address" {
-			parsedArg, ok := arg.(string)
-			if !ok {
-				return nil, fmt.Errorf(
-					"argument %d (%v) should be of type 'string', but type '%v' was provided",
-					i,
-					input.Name,
-					reflect.TypeOf(arg),
-				)
-			}
-
-			arg = common.HexToAddress(parsedArg)
-		} else if strings.Contains(inputType, "int") {
-			parsedArg, ok := arg.(int)
-			if !ok {
-				return nil, fmt.Errorf(
-					"argument %d (%v) should be of type 'int', but type '%v' was provided",
-					i,
-					input.Name,
-					reflect.TypeOf(arg),
-				)
-			}
-
-			arg = big.NewInt(int64(parsedArg))
-		}
-
-		typedArgs = append(typedArgs, arg)
+	abiMethod, typedArgs, err := c.Encoder.parseArgs(method, args)
+	if err != nil {
+		return nil, err
 	}
 
 	if abiMethod.StateMutability == "view" {
@@ -196,15 +147,28 @@ func (c *SmartContract) Call(ctx context.Context, method string, args ...interfa
 
 		return out, nil
 	} else {
-		txOpts, err := c.Helper.GetTxOptions(ctx)
-		if err != nil {
-			return nil, err
-		}
-		tx, err := c.contract.Transact(txOpts, method, typedArgs...)
+		tx, err := c.Encoder.Prepare(ctx, method, args...)
 		if err != nil {
 			return nil, err
 		}
 
-		return c.Helper.AwaitTx(ctx, tx.Hash())
+		return tx.SendAndWait(ctx)
 	}
 }
+
+// Prepare a transaction to any function on your contract without sending it.
+//
+// method: the name of the method on your contract you want to call
+//
+// args: the arguments to pass to the method
+//
+// returns: the prepared transaction for the call
+//
+// Example
+//
+//	tx, err := contract.Prepare(context.Background(), "mintTo", "{{wallet_address}}", "ipfs://...")
+//	estimate, err := tx.Estimate(context.Background())
+//	result, err := tx.SendAndWait(context.Background())
+func (c *SmartContract) Prepare(ctx context.Context, method string, args ...interface{}) (*PreparedTx, error) {
+	return c.Encoder.Prepare(ctx, method, args...)
+}
@@ -2,6 +2,7 @@ package web3sdks
 
 import (
 	"context"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
@@ -29,16 +30,16 @@ type Token struct {
 	abi     *abi.TokenERC20
 	Helper  *contractHelper
 	Encoder *ContractEncoder
-	Events  *ContractEvents
+	Events  *TokenEvents
 }
 
-func newToken(provider *ethclient.Client, address common.Address, privateKey string, storage storage) (*Token, error) {
-	if contractAbi, err := abi.NewTokenERC20(address, provider); err != nil {
+func newToken(provider *ethclient.Client, address common.Address, handler *ProviderHandler, storage storage) (*Token, error) {
+	if contractAbi, err := abi.NewTokenERC20(address, handler.getContractBackend()); err != nil {
 		return nil, err
-	} else if helper, err := newContractHelper(address, provider, privateKey); err != nil {
+	} else if helper, err := newContractHelper(address, handler); err != nil {
 		return nil, err
 	} else {
-		if erc20, err := newERC20(provider, address, privateKey, storage); err != nil {
+		if erc20, err := newERC20(provider, address, handler, storage); err != nil {
 			return nil, err
 		} else {
 			encoder, err := newContractEncoder(abi.TokenERC20ABI, helper)
@@ -46,7 +47,7 @@ func newToken(provider *ethclient.Client, address common.Address, privateKey str
 				return nil, err
 			}
 
-			events, err := newContractEvents(abi.TokenERC20ABI, helper)
+			events, err := newTokenEvents(contractAbi, helper)
 			if err != nil {
 				return nil, err
 			}
@@ -103,15 +104,104 @@ func (token *Token) GetDelegationOf(ctx context.Context, address string) (string
 	return delegation.String(), nil
 }
 
+// Get the holders of the token at a block, reconstructed from all its transfers. The transfers
+// are fetched in chunks, and the holders are reconstructed again if the block is reorged meanwhile.
+//
+// atBlock: the block to get the holders at, nil for the latest block minus the confirmations
+//
+// options: optional options of the reconstruction, like the deployment block of the token, the
+// minimum balance of the holders and their order
+//
+// returns: the holders of the token at the block, with their balances and shares of the supply
+//
+// Example
+//
+//	holders, err := contract.GetHolders(context.Background(), nil, &web3sdks.TokenHoldersOptions{
+//	  FromBlock:     30000000,
+//	  Confirmations: 10,
+//	  MinBalance:    big.NewInt(1000000000000000000),
+//	})
+//
+//	for _, holder := range holders.Holders {
+//	  fmt.Println(holder.Address, holder.DisplayValue)
+//	}
+//
+//	// Share of the supply held by the 10 largest holders
+//	fmt.Println(holders.GetConcentration(10).Share)
+//
+//	// Export the holders for an airdrop
+//	file, err := os.Create("holders.csv")
+//	defer file.Close()
+//	err = holders.WriteCSV(file)
+func (token *Token) GetHolders(ctx context.Context, atBlock *uint64, options ...*TokenHoldersOptions) (*TokenHolders, error) {
+	holdersOptions := TokenHoldersOptions{}
+	if len(options) > 0 && options[0] != nil {
+		holdersOptions = *options[0]
+	}
+
+	currency, err := token.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return getTokenHolders(ctx, token.Events, currency.Decimals, atBlock, holdersOptions)
+}
+
 // Mint tokens to the connected wallet.
 //
 // amount: amount of tokens to mint
 //
 // returns: transaction receipt of the mint
-func (token *Token) Mint(ctx context.Context, amount float64) (*types.Transaction, error) {
+func (token *Token) Mint(ctx context.Context, amount float64) (*TransactionResult, error) {
 	return token.MintTo(ctx, token.Helper.GetSignerAddress().String(), amount)
 }
 
+// Prepare the mint of tokens to the connected wallet without sending it.
+//
+// amount: amount of tokens to mint
+//
+// returns: the prepared mint transaction
+func (token *Token) PrepareMint(ctx context.Context, amount float64) (*PreparedTx, error) {
+	return token.PrepareMintTo(ctx, token.Helper.GetSignerAddress().String(), amount)
+}
+
+// Mint tokens to the connected wallet with an exact decimal amount.
+//
+// amount: exact decimal amount of tokens, with at most as many decimals as the token
+//
+// returns: transaction receipt of the mint
+func (token *Token) MintString(ctx context.Context, amount string) (*TransactionResult, error) {
+	return token.MintToString(ctx, token.Helper.GetSignerAddress().String(), amount)
+}
+
+// Prepare the mint of tokens to the connected wallet with an exact decimal amount without sending it.
+//
+// amount: exact decimal amount of tokens, with at most as many decimals as the token
+//
+// returns: the prepared mint transaction
+func (token *Token) PrepareMintString(ctx context.Context, amount string) (*PreparedTx, error) {
+	return token.PrepareMintToString(ctx, token.Helper.GetSignerAddress().String(), amount)
+}
+
+// Mint tokens to the connected wallet, with an amount in the smallest unit of the token.
+//
+// amount: amount of tokens in the smallest unit of the token
+//
+// returns: transaction receipt of the mint
+func (token *Token) MintBigInt(ctx context.Context, amount *big.Int) (*TransactionResult, error) {
+	return token.MintToBigInt(ctx, token.Helper.GetSignerAddress().String(), amount)
+}
+
+// Prepare the mint of tokens to the connected wallet, with an amount in the smallest unit of the
+// token, without sending it.
+//
+// amount: amount of tokens in the smallest unit of the token
+//
+// returns: the prepared mint transaction
+func (token *Token) PrepareMintBigInt(ctx context.Context, amount *big.Int) (*PreparedTx, error) {
+	return token.PrepareMintToBigInt(ctx, token.Helper.GetSignerAddress().String(), amount)
+}
+
 // Mint tokens to a specified wallet.
 //
 // to: wallet address to mint tokens to
@@ -123,22 +213,106 @@ func (token *Token) Mint(ctx context.Context, amount float64) (*types.Transactio
 // Example
 //
 //	tx, err := contract.MintTo(context.Background(), "{{wallet_address}}", 1)
-func (token *Token) MintTo(ctx context.Context, to string, amount float64) (*types.Transaction, error) {
+func (token *Token) MintTo(ctx context.Context, to string, amount float64) (*TransactionResult, error) {
+	tx, err := token.PrepareMintTo(ctx, to, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the mint of tokens to a specified wallet without sending it.
+//
+// to: wallet address to mint tokens to
+//
+// amount: amount of tokens to mint
+//
+// returns: the prepared mint transaction
+//
+// Example
+//
+//	tx, err := contract.PrepareMintTo(context.Background(), "{{wallet_address}}", 1)
+//	estimate, err := tx.Estimate(context.Background())
+func (token *Token) PrepareMintTo(ctx context.Context, to string, amount float64) (*PreparedTx, error) {
 	amountWithDecimals, err := token.normalizeAmount(ctx, amount)
 	if err != nil {
 		return nil, err
 	}
 
-	txOpts, err := token.Helper.GetTxOptions(ctx)
+	return token.PrepareMintToBigInt(ctx, to, amountWithDecimals)
+}
+
+// Mint tokens to a specified wallet, with an exact decimal amount like "1.5".
+//
+// to: wallet address to mint tokens to
+//
+// amount: exact decimal amount of tokens, with at most as many decimals as the token
+//
+// returns: the transaction receipt
+//
+// Example
+//
+//	tx, err := contract.MintToString(context.Background(), "{{wallet_address}}", "0.000000000000000001")
+func (token *Token) MintToString(ctx context.Context, to string, amount string) (*TransactionResult, error) {
+	tx, err := token.PrepareMintToString(ctx, to, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the mint of tokens to a specified wallet with an exact decimal amount without sending it.
+//
+// to: wallet address to mint tokens to
+//
+// amount: exact decimal amount of tokens, with at most as many decimals as the token
+//
+// returns: the prepared transaction
+func (token *Token) PrepareMintToString(ctx context.Context, to string, amount string) (*PreparedTx, error) {
+	amountWithDecimals, err := token.normalizeAmountString(ctx, amount)
 	if err != nil {
 		return nil, err
 	}
-	tx, err := token.abi.MintTo(txOpts, common.HexToAddress(to), amountWithDecimals)
+
+	return token.PrepareMintToBigInt(ctx, to, amountWithDecimals)
+}
+
+// Mint tokens to a specified wallet, with an amount in the smallest unit of the token, like wei for
+// a token with 18 decimals.
+//
+// to: wallet address to mint tokens to
+//
+// amount: amount of tokens in the smallest unit of the token
+//
+// returns: the transaction receipt
+//
+// Example
+//
+//	amount, _ := new(big.Int).SetString("1500000000000000000", 10)
+//	tx, err := contract.MintToBigInt(context.Background(), "{{wallet_address}}", amount)
+func (token *Token) MintToBigInt(ctx context.Context, to string, amount *big.Int) (*TransactionResult, error) {
+	tx, err := token.PrepareMintToBigInt(ctx, to, amount)
 	if err != nil {
 		return nil, err
 	}
 
-	return token.Helper.AwaitTx(ctx, tx.Hash())
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the mint of tokens to a specified wallet with an amount in the smallest unit of the token
+// without sending it.
+//
+// to: wallet address to mint tokens to
+//
+// amount: amount of tokens in the smallest unit of the token
+//
+// returns: the prepared transaction
+func (token *Token) PrepareMintToBigInt(ctx context.Context, to string, amount *big.Int) (*PreparedTx, error) {
+	return newPreparedTx(token.Helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return token.abi.MintTo(opts, common.HexToAddress(to), amount)
+	}), nil
 }
 
 // Mint tokens to a list of wallets.
@@ -161,37 +335,40 @@ func (token *Token) MintTo(ctx context.Context, to string, amount float64) (*typ
 //	}
 //
 //	tx, err := contract.MintBatchTo(context.Background(), args)
-func (token *Token) MintBatchTo(ctx context.Context, args []*TokenAmount) (*types.Transaction, error) {
+func (token *Token) MintBatchTo(ctx context.Context, args []*TokenAmount) (*TransactionResult, error) {
+	tx, err := token.PrepareMintBatchTo(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the mint of tokens to a list of wallets without sending it.
+//
+// args: list of wallet addresses and amounts to mint
+//
+// returns: the prepared mint transaction
+func (token *Token) PrepareMintBatchTo(ctx context.Context, args []*TokenAmount) (*PreparedTx, error) {
 	encoded := [][]byte{}
 
 	for _, arg := range args {
-		amountWithDecimals, err := token.normalizeAmount(ctx, arg.Amount)
+		amountWithDecimals, err := token.normalizeTokenAmount(ctx, arg)
 		if err != nil {
 			return nil, err
 		}
 
-		txOpts, err := token.Helper.getEncodedTxOptions(ctx)
-		if err != nil {
-			return nil, err
-		}
-		tx, err := token.abi.MintTo(txOpts, common.HexToAddress(arg.ToAddress), amountWithDecimals)
+		data, err := encodeCallData(abi.TokenERC20MetaData, "mintTo", common.HexToAddress(arg.ToAddress), amountWithDecimals)
 		if err != nil {
 			return nil, err
 		}
 
-		encoded = append(encoded, tx.Data())
+		encoded = append(encoded, data)
 	}
 
-	txOpts, err := token.Helper.GetTxOptions(ctx)
-	if err != nil {
-		return nil, err
-	}
-	tx, err := token.abi.Multicall(txOpts, encoded)
-	if err != nil {
-		return nil, err
-	}
-
-	return token.Helper.AwaitTx(ctx, tx.Hash())
+	return newPreparedTx(token.Helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return token.abi.Multicall(opts, encoded)
+	}), nil
 }
 
 // Delegate the connected wallets tokens to a specified wallet.
@@ -199,15 +376,22 @@ func (token *Token) MintBatchTo(ctx context.Context, args []*TokenAmount) (*type
 // delegateeAddress: wallet address to delegate tokens to
 //
 // returns: transaction receipt of the delegation
-func (token *Token) DelegateTo(ctx context.Context, delegatreeAddress string) (*types.Transaction, error) {
-	txOpts, err := token.Helper.GetTxOptions(ctx)
-	if err != nil {
-		return nil, err
-	}
-	tx, err := token.abi.Delegate(txOpts, common.HexToAddress(delegatreeAddress))
+func (token *Token) DelegateTo(ctx context.Context, delegatreeAddress string) (*TransactionResult, error) {
+	tx, err := token.PrepareDelegateTo(ctx, delegatreeAddress)
 	if err != nil {
 		return nil, err
 	}
 
-	return token.Helper.AwaitTx(ctx, tx.Hash())
+	return tx.SendAndWait(ctx)
+}
+
+// Prepare the delegation of the connected wallets tokens to a specified wallet without sending it.
+//
+// delegateeAddress: wallet address to delegate tokens to
+//
+// returns: the prepared delegation transaction
+func (token *Token) PrepareDelegateTo(ctx context.Context, delegatreeAddress string) (*PreparedTx, error) {
+	return newPreparedTx(token.Helper, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return token.abi.Delegate(opts, common.HexToAddress(delegatreeAddress))
+	}), nil
 }
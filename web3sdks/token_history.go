@@ -0,0 +1,159 @@
+package web3sdks
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Kind of a token activity.
+type TokenActivityType string
+
+const (
+	// Tokens created, transferred from the zero address
+	TokenActivityMint TokenActivityType = "mint"
+	// Tokens transferred from one wallet to another
+	TokenActivityTransfer TokenActivityType = "transfer"
+	// Tokens destroyed, transferred to the zero address
+	TokenActivityBurn TokenActivityType = "burn"
+)
+
+// A mint, transfer or burn of tokens, decoded from a transfer event.
+type TokenActivity struct {
+	Type    TokenActivityType
+	TokenId *big.Int
+	From    string
+	To      string
+	// Number of tokens moved, always 1 for ERC721 tokens
+	Quantity        *big.Int
+	BlockNumber     uint64
+	Timestamp       time.Time
+	TransactionHash string
+	LogIndex        uint
+}
+
+// Query of the transfer events of a token activity
+type tokenActivityQuery struct {
+	eventName string
+	filters   map[string]interface{}
+}
+
+// Get the options of a history query, with the default options if there are none
+func getTokenActivityOptions(options []*EventQueryOptions) EventQueryOptions {
+	if len(options) > 0 && options[0] != nil {
+		return *options[0]
+	}
+
+	return EventQueryOptions{}
+}
+
+// Get the events of all the queries, each event once, in the order of the options and up to their
+// limit. The filters of the options are replaced by the filters of every query
+func getTokenActivityEvents(
+	ctx context.Context,
+	events *ContractEvents,
+	queries []tokenActivityQuery,
+	options EventQueryOptions,
+) ([]ContractEvent, error) {
+	found := map[eventLogKey]bool{}
+	merged := []ContractEvent{}
+	for _, query := range queries {
+		queryOptions := options
+		queryOptions.Filters = query.filters
+
+		queryEvents, err := events.GetEvents(ctx, query.eventName, queryOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, event := range queryEvents {
+			key := getEventLogKey(event.Transaction)
+			if !found[key] {
+				found[key] = true
+				merged = append(merged, event)
+			}
+		}
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		a, b := merged[i].Transaction, merged[j].Transaction
+		if options.Order == EventOrderDescending {
+			a, b = b, a
+		}
+		return a.BlockNumber < b.BlockNumber || (a.BlockNumber == b.BlockNumber && a.Index < b.Index)
+	})
+
+	if options.Limit > 0 && len(merged) > options.Limit {
+		merged = merged[:options.Limit]
+	}
+
+	return merged, nil
+}
+
+// Create the activity of a transfer event, without its timestamp
+func newTokenActivity(event ContractEvent, tokenId *big.Int, quantity *big.Int) *TokenActivity {
+	from := event.Data["from"].(common.Address)
+	to := event.Data["to"].(common.Address)
+
+	activityType := TokenActivityTransfer
+	if from == (common.Address{}) {
+		activityType = TokenActivityMint
+	} else if to == (common.Address{}) {
+		activityType = TokenActivityBurn
+	}
+
+	return &TokenActivity{
+		Type:            activityType,
+		TokenId:         tokenId,
+		From:            from.String(),
+		To:              to.String(),
+		Quantity:        quantity,
+		BlockNumber:     event.Transaction.BlockNumber,
+		TransactionHash: event.Transaction.TxHash.String(),
+		LogIndex:        event.Transaction.Index,
+	}
+}
+
+// Set the timestamps of the activities from the headers of their blocks, fetching every block once
+func setTokenActivityTimestamps(ctx context.Context, helper *contractHelper, activities []*TokenActivity) error {
+	blockNumbers := []uint64{}
+	timestamps := map[uint64]time.Time{}
+	for _, activity := range activities {
+		if _, ok := timestamps[activity.BlockNumber]; !ok {
+			timestamps[activity.BlockNumber] = time.Time{}
+			blockNumbers = append(blockNumbers, activity.BlockNumber)
+		}
+	}
+
+	mu := sync.Mutex{}
+	errs := make([]error, len(blockNumbers))
+	forEachInParallel(ctx, len(blockNumbers), helper.getFetchConcurrency(), func(i int) {
+		header, err := helper.GetProvider().HeaderByNumber(ctx, new(big.Int).SetUint64(blockNumbers[i]))
+		if err != nil {
+			errs[i] = err
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		timestamps[blockNumbers[i]] = time.Unix(int64(header.Time), 0)
+	})
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, activity := range activities {
+		activity.Timestamp = timestamps[activity.BlockNumber]
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package web3sdks
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/web3sdks/go-sdk/v2/abi"
+)
+
+// Mock a chain with the given logs, whose blocks are mined 10 seconds apart
+func handleMockTokenLogs(t *testing.T, server *mockRpcServer, logs []types.Log) {
+	server.handle("eth_blockNumber", func(params []json.RawMessage) (interface{}, error) {
+		return hexutil.EncodeUint64(10), nil
+	})
+	server.handle("eth_getLogs", func(params []json.RawMessage) (interface{}, error) {
+		criteria := struct {
+			FromBlock string          `json:"fromBlock"`
+			ToBlock   string          `json:"toBlock"`
+			Topics    [][]common.Hash `json:"topics"`
+		}{}
+		if err := json.Unmarshal(params[0], &criteria); err != nil {
+			return nil, err
+		}
+		from, _ := hexutil.DecodeUint64(criteria.FromBlock)
+		to, _ := hexutil.DecodeUint64(criteria.ToBlock)
+
+		matching := []types.Log{}
+		for _, log := range logs {
+			if matchesIndexerLogFilter(log, IndexerLogFilter{Topics: criteria.Topics, FromBlock: from, ToBlock: &to}) {
+				matching = append(matching, log)
+			}
+		}
+
+		return matching, nil
+	})
+	server.handle("eth_getBlockByNumber", func(params []json.RawMessage) (interface{}, error) {
+		var number string
+		if err := json.Unmarshal(params[0], &number); err != nil {
+			return nil, err
+		}
+		blockNumber, _ := hexutil.DecodeUint64(number)
+
+		header := mockHeader(int64(blockNumber), 10)
+		header.Time = 1000 + blockNumber*10
+		return header, nil
+	})
+}
+
+func newMockTokenLog(blockNumber uint64, topics []common.Hash, data []byte) types.Log {
+	return types.Log{
+		Address:     common.HexToAddress(secondaryWallet),
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: blockNumber,
+		TxHash:      common.BigToHash(new(big.Int).SetUint64(blockNumber)),
+	}
+}
+
+func newMockERC721Transfer(blockNumber uint64, from string, to string, tokenId int64) types.Log {
+	tokenAbi, _ := abi.TokenERC721MetaData.GetAbi()
+	topics := []common.Hash{
+		tokenAbi.Events["Transfer"].ID,
+		common.HexToHash(from),
+		common.HexToHash(to),
+		common.BigToHash(big.NewInt(tokenId)),
+	}
+
+	return newMockTokenLog(blockNumber, topics, []byte{})
+}
+
+func newMockERC1155Transfer(t *testing.T, blockNumber uint64, from string, to string, ids []*big.Int, values []*big.Int) types.Log {
+	tokenAbi, err := abi.TokenERC1155MetaData.GetAbi()
+	assert.Nil(t, err)
+
+	event := tokenAbi.Events["TransferBatch"]
+	var data []byte
+	if len(ids) == 1 {
+		event = tokenAbi.Events["TransferSingle"]
+		data, err = event.Inputs.NonIndexed().Pack(ids[0], values[0])
+	} else {
+		data, err = event.Inputs.NonIndexed().Pack(ids, values)
+	}
+	assert.Nil(t, err)
+
+	topics := []common.Hash{event.ID, common.HexToHash(adminWallet), common.HexToHash(from), common.HexToHash(to)}
+	return newMockTokenLog(blockNumber, topics, data)
+}
+
+func getActivityTypes(activities []*TokenActivity) []TokenActivityType {
+	activityTypes := []TokenActivityType{}
+	for _, activity := range activities {
+		activityTypes = append(activityTypes, activity.Type)
+	}
+
+	return activityTypes
+}
+
+func TestTokenHistoryERC721(t *testing.T) {
+	server := newMockRpcServer()
+	defer server.Close()
+	handleMockTokenLogs(t, server, []types.Log{
+		newMockERC721Transfer(1, zeroAddress, adminWallet, 42),
+		newMockERC721Transfer(2, zeroAddress, secondaryWallet, 7),
+		newMockERC721Transfer(3, adminWallet, secondaryWallet, 42),
+		newMockERC721Transfer(5, secondaryWallet, zeroAddress, 42),
+	})
+
+	helper := server.helper(t)
+	erc721, err := newERC721(helper.GetProvider(), helper.getAddress(), helper.ProviderHandler, nil)
+	assert.Nil(t, err)
+
+	history, err := erc721.GetHistory(context.Background(), 42)
+	assert.Nil(t, err)
+	assert.Equal(t, []TokenActivityType{TokenActivityMint, TokenActivityTransfer, TokenActivityBurn}, getActivityTypes(history))
+	assert.Equal(t, common.HexToAddress(adminWallet).String(), history[1].From)
+	assert.Equal(t, common.HexToAddress(secondaryWallet).String(), history[1].To)
+	assert.Equal(t, int64(42), history[1].TokenId.Int64())
+	assert.Equal(t, int64(1), history[1].Quantity.Int64())
+	assert.Equal(t, time.Unix(1030, 0), history[1].Timestamp)
+	assert.Equal(t, 3, server.callCount("eth_getBlockByNumber"))
+
+	history, err = erc721.GetHistory(context.Background(), 42, &EventQueryOptions{Order: EventOrderDescending, Limit: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, []TokenActivityType{TokenActivityBurn}, getActivityTypes(history))
+	assert.Equal(t, uint64(5), history[0].BlockNumber)
+
+	activity, err := erc721.GetActivity(context.Background(), secondaryWallet)
+	assert.Nil(t, err)
+	assert.Equal(t, []TokenActivityType{TokenActivityMint, TokenActivityTransfer, TokenActivityBurn}, getActivityTypes(activity))
+	assert.Equal(t, int64(7), activity[0].TokenId.Int64())
+	assert.Equal(t, uint64(3), activity[1].BlockNumber)
+}
+
+func TestTokenHistoryERC1155(t *testing.T) {
+	server := newMockRpcServer()
+	defer server.Close()
+	handleMockTokenLogs(t, server, []types.Log{
+		newMockERC1155Transfer(t, 1, zeroAddress, adminWallet, []*big.Int{big.NewInt(1)}, []*big.Int{big.NewInt(10)}),
+		newMockERC1155Transfer(t, 2, adminWallet, secondaryWallet, []*big.Int{big.NewInt(1), big.NewInt(2)}, []*big.Int{big.NewInt(3), big.NewInt(4)}),
+		newMockERC1155Transfer(t, 4, secondaryWallet, zeroAddress, []*big.Int{big.NewInt(2)}, []*big.Int{big.NewInt(1)}),
+	})
+
+	helper := server.helper(t)
+	erc1155, err := newERC1155(helper.GetProvider(), helper.getAddress(), helper.ProviderHandler, nil)
+	assert.Nil(t, err)
+
+	// The transfers of the other tokens of a batch are left out
+	history, err := erc1155.GetHistory(context.Background(), 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []TokenActivityType{TokenActivityTransfer, TokenActivityBurn}, getActivityTypes(history))
+	assert.Equal(t, int64(4), history[0].Quantity.Int64())
+	assert.Equal(t, time.Unix(1040, 0), history[1].Timestamp)
+
+	history, err = erc1155.GetHistory(context.Background(), 1, &EventQueryOptions{Limit: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, []TokenActivityType{TokenActivityMint}, getActivityTypes(history))
+	assert.Equal(t, int64(10), history[0].Quantity.Int64())
+
+	activity, err := erc1155.GetActivity(context.Background(), adminWallet)
+	assert.Nil(t, err)
+	assert.Equal(t, []TokenActivityType{TokenActivityMint, TokenActivityTransfer, TokenActivityTransfer}, getActivityTypes(activity))
+	assert.Equal(t, int64(2), activity[2].TokenId.Int64())
+	assert.Equal(t, int64(4), activity[2].Quantity.Int64())
+}
+
+func TestTokenHistoryKeepsLargeTokenIds(t *testing.T) {
+	// Token ids packing data in their high bits, which don't fit in an int
+	tokenId, _ := new(big.Int).SetString("340282366920938463463374607431768211457", 10)
+
+	server := newMockRpcServer()
+	defer server.Close()
+	handleMockTokenLogs(t, server, []types.Log{
+		newMockERC1155Transfer(t, 1, zeroAddress, adminWallet, []*big.Int{tokenId}, []*big.Int{big.NewInt(1)}),
+	})
+
+	helper := server.helper(t)
+	erc1155, err := newERC1155(helper.GetProvider(), helper.getAddress(), helper.ProviderHandler, nil)
+	assert.Nil(t, err)
+
+	activity, err := erc1155.GetActivity(context.Background(), adminWallet)
+	assert.Nil(t, err)
+	assert.Len(t, activity, 1)
+	assert.Equal(t, tokenId.String(), activity[0].TokenId.String())
+}
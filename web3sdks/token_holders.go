@@ -0,0 +1,385 @@
+package web3sdks
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Number of blocks whose transfers are fetched at once when the holders of a token are
+	// reconstructed, so that the transfers of a long history aren't all kept in memory
+	tokenHoldersBatchSize = 10000
+	// Number of times the holders of a token are reconstructed again when their block is reorged
+	// while the transfers are fetched
+	tokenHoldersReorgRetries = 3
+)
+
+// Order of the holders of a token.
+type TokenHoldersOrder string
+
+const (
+	// Largest balances first, holders with the same balance ordered by address
+	TokenHoldersByBalance TokenHoldersOrder = "balance"
+	// Ordered by address
+	TokenHoldersByAddress TokenHoldersOrder = "address"
+)
+
+// Options of the reconstruction of the holders of a token.
+type TokenHoldersOptions struct {
+	// Block the token was deployed at, the transfers before it are skipped. Defaults to 0
+	FromBlock uint64
+	// Number of blocks mined on top of the block the holders are reconstructed at when no block
+	// is given, so that the block is unlikely to be reorged. Defaults to 0, the latest block
+	Confirmations uint64
+	// Maximum number of blocks queried in a single request, like for GetEvents. Defaults to 0,
+	// querying the blocks of a batch at once
+	MaxChunkSize uint64
+	// Minimum balance of the holders to return, in the smallest unit of the token. Defaults to
+	// nil, returning all the wallets with a positive balance
+	MinBalance *big.Int
+	// Order of the holders, by balance by default
+	Order TokenHoldersOrder
+}
+
+// A wallet holding a token.
+type TokenHolder struct {
+	Address string
+	// Balance in the smallest unit of the token
+	Balance *big.Int
+	// Balance as an exact decimal string, like "1.5"
+	DisplayValue string
+	// Share of the total supply held by the wallet, between 0 and 1
+	Share float64
+}
+
+// The holders of a token at a block, reconstructed from its transfers.
+type TokenHolders struct {
+	BlockNumber uint64
+	BlockHash   string
+	Decimals    int
+	// Total supply at the block, held by all the holders including the ones below the minimum
+	// balance
+	TotalSupply *big.Int
+	// Number of wallets with a positive balance, including the ones below the minimum balance
+	HolderCount int
+	Holders     []*TokenHolder
+}
+
+// The part of the supply of a token held by its largest holders.
+type TokenHolderConcentration struct {
+	// Number of holders counted, which is less than requested if there are fewer holders
+	Top int
+	// Balance held by the holders together, in the smallest unit of the token
+	Balance *big.Int
+	// Balance held by the holders together as an exact decimal string
+	DisplayValue string
+	// Share of the total supply held by the holders together, between 0 and 1
+	Share float64
+}
+
+type tokenHolderJSON struct {
+	Address      string  `json:"address"`
+	Balance      string  `json:"balance"`
+	DisplayValue string  `json:"displayValue"`
+	Share        float64 `json:"share"`
+}
+
+type tokenHoldersJSON struct {
+	BlockNumber uint64             `json:"blockNumber"`
+	BlockHash   string             `json:"blockHash"`
+	Decimals    int                `json:"decimals"`
+	TotalSupply string             `json:"totalSupply"`
+	HolderCount int                `json:"holderCount"`
+	Holders     []*tokenHolderJSON `json:"holders"`
+}
+
+// Get the part of the supply held by the largest holders.
+//
+// top: the number of largest holders to count, none if it isn't positive
+//
+// returns: the balance and share of the supply held by the largest holders, out of the holders
+// above the minimum balance
+//
+// Example
+//
+//	// Share of the supply held by the 10 largest holders
+//	concentration := holders.GetConcentration(10)
+//	fmt.Println(concentration.Share)
+func (holders *TokenHolders) GetConcentration(top int) *TokenHolderConcentration {
+	largest := append([]*TokenHolder{}, holders.Holders...)
+	sortTokenHolders(largest, TokenHoldersByBalance)
+	if top < 0 {
+		top = 0
+	}
+	if top < len(largest) {
+		largest = largest[:top]
+	}
+
+	balance := big.NewInt(0)
+	for _, holder := range largest {
+		balance.Add(balance, holder.Balance)
+	}
+
+	return &TokenHolderConcentration{
+		Top:          len(largest),
+		Balance:      balance,
+		DisplayValue: formatUnitsString(balance, holders.Decimals),
+		Share:        getTokenHolderShare(balance, holders.TotalSupply),
+	}
+}
+
+// Write the holders as CSV, with a header row and one row per holder.
+//
+// w: the writer of the CSV, like a file
+//
+// Example
+//
+//	file, err := os.Create("holders.csv")
+//	defer file.Close()
+//
+//	err = holders.WriteCSV(file)
+func (holders *TokenHolders) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"address", "balance", "display_value", "share"}); err != nil {
+		return err
+	}
+
+	for _, holder := range holders.Holders {
+		err := writer.Write([]string{
+			holder.Address,
+			holder.Balance.String(),
+			holder.DisplayValue,
+			strconv.FormatFloat(holder.Share, 'f', -1, 64),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// Write the holders as JSON, with the balances as strings so that they aren't rounded by JSON
+// parsers.
+//
+// w: the writer of the JSON, like a file
+//
+// Example
+//
+//	file, err := os.Create("holders.json")
+//	defer file.Close()
+//
+//	err = holders.WriteJSON(file)
+func (holders *TokenHolders) WriteJSON(w io.Writer) error {
+	output := &tokenHoldersJSON{
+		BlockNumber: holders.BlockNumber,
+		BlockHash:   holders.BlockHash,
+		Decimals:    holders.Decimals,
+		TotalSupply: holders.TotalSupply.String(),
+		HolderCount: holders.HolderCount,
+		Holders:     []*tokenHolderJSON{},
+	}
+	for _, holder := range holders.Holders {
+		output.Holders = append(output.Holders, &tokenHolderJSON{
+			Address:      holder.Address,
+			Balance:      holder.Balance.String(),
+			DisplayValue: holder.DisplayValue,
+			Share:        holder.Share,
+		})
+	}
+
+	return json.NewEncoder(w).Encode(output)
+}
+
+// Reconstruct the holders of a token at a block from its transfers. The block hash is checked once
+// the transfers are fetched, and the holders are reconstructed again if the block was reorged
+func getTokenHolders(
+	ctx context.Context,
+	events *TokenEvents,
+	decimals int,
+	atBlock *uint64,
+	options TokenHoldersOptions,
+) (*TokenHolders, error) {
+	provider := events.helper.GetProvider()
+
+	var blockNumber uint64
+	if atBlock != nil {
+		blockNumber = *atBlock
+	} else {
+		latestBlock, err := provider.BlockNumber(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if latestBlock > options.Confirmations {
+			blockNumber = latestBlock - options.Confirmations
+		}
+	}
+	if blockNumber < options.FromBlock {
+		return nil, fmt.Errorf("Block %d is before the block %d the holders are reconstructed from", blockNumber, options.FromBlock)
+	}
+
+	for attempt := 0; ; attempt++ {
+		header, err := provider.HeaderByNumber(ctx, new(big.Int).SetUint64(blockNumber))
+		if err != nil {
+			return nil, err
+		}
+
+		balances, err := getTokenBalances(ctx, events, blockNumber, options)
+		if err != nil {
+			return nil, err
+		}
+
+		currentHeader, err := provider.HeaderByNumber(ctx, new(big.Int).SetUint64(blockNumber))
+		if err != nil {
+			return nil, err
+		}
+		if currentHeader.Hash() == header.Hash() {
+			return newTokenHolders(balances, blockNumber, header.Hash(), decimals, options)
+		}
+
+		if attempt >= tokenHoldersReorgRetries {
+			return nil, fmt.Errorf("Block %d was reorged while the holders were reconstructed", blockNumber)
+		}
+	}
+}
+
+// Get the balance of every wallet that received the token up to a block, fetching the transfers
+// one batch of blocks at a time
+func getTokenBalances(
+	ctx context.Context,
+	events *TokenEvents,
+	blockNumber uint64,
+	options TokenHoldersOptions,
+) (map[common.Address]*big.Int, error) {
+	balances := map[common.Address]*big.Int{}
+	getBalance := func(address common.Address) *big.Int {
+		if _, ok := balances[address]; !ok {
+			balances[address] = big.NewInt(0)
+		}
+		return balances[address]
+	}
+
+	for fromBlock := options.FromBlock; fromBlock <= blockNumber; {
+		toBlock := blockNumber
+		if toBlock-fromBlock+1 > tokenHoldersBatchSize {
+			toBlock = fromBlock + tokenHoldersBatchSize - 1
+		}
+
+		transfers, err := events.GetTransfers(ctx, EventQueryOptions{
+			FromBlock:    fromBlock,
+			ToBlock:      &toBlock,
+			MaxChunkSize: options.MaxChunkSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, transfer := range transfers {
+			if transfer.Raw.Removed {
+				continue
+			}
+			if transfer.From != (common.Address{}) {
+				balance := getBalance(transfer.From)
+				balance.Sub(balance, transfer.Value)
+			}
+			if transfer.To != (common.Address{}) {
+				balance := getBalance(transfer.To)
+				balance.Add(balance, transfer.Value)
+			}
+		}
+
+		fromBlock = toBlock + 1
+	}
+
+	return balances, nil
+}
+
+// Create the holders of the wallets with a positive balance, filtered by minimum balance and sorted.
+// A negative balance means that transfers are missing, when the token was deployed before the
+// first block
+func newTokenHolders(
+	balances map[common.Address]*big.Int,
+	blockNumber uint64,
+	blockHash common.Hash,
+	decimals int,
+	options TokenHoldersOptions,
+) (*TokenHolders, error) {
+	order := options.Order
+	if order == "" {
+		order = TokenHoldersByBalance
+	}
+	if order != TokenHoldersByBalance && order != TokenHoldersByAddress {
+		return nil, fmt.Errorf("Unknown token holders order '%s'", order)
+	}
+
+	totalSupply := big.NewInt(0)
+	holderCount := 0
+	for address, balance := range balances {
+		if balance.Sign() < 0 {
+			return nil, fmt.Errorf(
+				"Balance of %s is negative, the holders must be reconstructed from the block the token was deployed at",
+				address.String(),
+			)
+		}
+		if balance.Sign() > 0 {
+			totalSupply.Add(totalSupply, balance)
+			holderCount++
+		}
+	}
+
+	holders := []*TokenHolder{}
+	for address, balance := range balances {
+		if balance.Sign() <= 0 || (options.MinBalance != nil && balance.Cmp(options.MinBalance) < 0) {
+			continue
+		}
+
+		holders = append(holders, &TokenHolder{
+			Address:      address.String(),
+			Balance:      balance,
+			DisplayValue: formatUnitsString(balance, decimals),
+			Share:        getTokenHolderShare(balance, totalSupply),
+		})
+	}
+	sortTokenHolders(holders, order)
+
+	return &TokenHolders{
+		BlockNumber: blockNumber,
+		BlockHash:   blockHash.String(),
+		Decimals:    decimals,
+		TotalSupply: totalSupply,
+		HolderCount: holderCount,
+		Holders:     holders,
+	}, nil
+}
+
+func sortTokenHolders(holders []*TokenHolder, order TokenHoldersOrder) {
+	sort.SliceStable(holders, func(i, j int) bool {
+		a, b := holders[i], holders[j]
+		if order == TokenHoldersByBalance {
+			if cmp := a.Balance.Cmp(b.Balance); cmp != 0 {
+				return cmp > 0
+			}
+		}
+
+		return bytes.Compare(common.HexToAddress(a.Address).Bytes(), common.HexToAddress(b.Address).Bytes()) < 0
+	})
+}
+
+func getTokenHolderShare(balance *big.Int, totalSupply *big.Int) float64 {
+	if totalSupply.Sign() == 0 {
+		return 0
+	}
+
+	return decimal.NewFromBigInt(balance, 0).DivRound(decimal.NewFromBigInt(totalSupply, 0), 18).InexactFloat64()
+}
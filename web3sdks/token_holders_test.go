@@ -0,0 +1,200 @@
+package web3sdks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/web3sdks/go-sdk/v2/abi"
+)
+
+const thirdWallet = "0x0000000000000000000000000000000000000003"
+
+func newMockERC20Transfer(t *testing.T, blockNumber uint64, from string, to string, value int64) types.Log {
+	tokenAbi, err := abi.TokenERC20MetaData.GetAbi()
+	assert.Nil(t, err)
+
+	event := tokenAbi.Events["Transfer"]
+	data, err := event.Inputs.NonIndexed().Pack(big.NewInt(value))
+	assert.Nil(t, err)
+
+	topics := []common.Hash{event.ID, common.HexToHash(from), common.HexToHash(to)}
+	return newMockTokenLog(blockNumber, topics, data)
+}
+
+// Mock a token with 2 decimals and the given transfers
+func newMockHoldersServer(t *testing.T, logs []types.Log) *mockRpcServer {
+	tokenAbi, err := abi.TokenERC20MetaData.GetAbi()
+	assert.Nil(t, err)
+
+	server := newMockRpcServer()
+	handleMockTokenLogs(t, server, logs)
+	server.handle("eth_call", func(params []json.RawMessage) (interface{}, error) {
+		msg := struct {
+			Data  hexutil.Bytes `json:"data"`
+			Input hexutil.Bytes `json:"input"`
+		}{}
+		json.Unmarshal(params[0], &msg)
+		if len(msg.Data) == 0 {
+			msg.Data = msg.Input
+		}
+
+		method, err := tokenAbi.MethodById(msg.Data[:4])
+		if err != nil {
+			return nil, err
+		}
+
+		var result []byte
+		switch method.Name {
+		case "name", "symbol":
+			result, err = method.Outputs.Pack("Token")
+		case "decimals":
+			result, err = method.Outputs.Pack(uint8(2))
+		default:
+			return nil, fmt.Errorf("Unexpected method %s", method.Name)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return hexutil.Encode(result), nil
+	})
+
+	return server
+}
+
+func getHolderAddresses(holders *TokenHolders) []string {
+	addresses := []string{}
+	for _, holder := range holders.Holders {
+		addresses = append(addresses, holder.Address)
+	}
+
+	return addresses
+}
+
+func TestTokenHoldersReconstructsBalances(t *testing.T) {
+	server := newMockHoldersServer(t, []types.Log{
+		newMockERC20Transfer(t, 1, zeroAddress, adminWallet, 1000),
+		newMockERC20Transfer(t, 2, adminWallet, secondaryWallet, 300),
+		newMockERC20Transfer(t, 3, adminWallet, thirdWallet, 50),
+		newMockERC20Transfer(t, 5, secondaryWallet, zeroAddress, 100),
+		newMockERC20Transfer(t, 9, thirdWallet, secondaryWallet, 50),
+	})
+	defer server.Close()
+
+	helper := server.helper(t)
+	token, err := newToken(helper.GetProvider(), helper.getAddress(), helper.ProviderHandler, nil)
+	assert.Nil(t, err)
+
+	// The last transfer isn't confirmed yet
+	holders, err := token.GetHolders(context.Background(), nil, &TokenHoldersOptions{Confirmations: 2, MaxChunkSize: 3})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(8), holders.BlockNumber)
+	assert.Equal(t, "900", holders.TotalSupply.String())
+	assert.Equal(t, 3, holders.HolderCount)
+	assert.Equal(t, []string{
+		common.HexToAddress(adminWallet).String(),
+		common.HexToAddress(secondaryWallet).String(),
+		common.HexToAddress(thirdWallet).String(),
+	}, getHolderAddresses(holders))
+	assert.Equal(t, "6.5", holders.Holders[0].DisplayValue)
+	assert.InDelta(t, 650.0/900, holders.Holders[0].Share, 1e-9)
+
+	concentration := holders.GetConcentration(2)
+	assert.Equal(t, 2, concentration.Top)
+	assert.Equal(t, "8.5", concentration.DisplayValue)
+	assert.InDelta(t, 850.0/900, concentration.Share, 1e-9)
+
+	// The count is clamped to the number of holders
+	assert.Equal(t, 3, holders.GetConcentration(10).Top)
+	concentration = holders.GetConcentration(-1)
+	assert.Equal(t, 0, concentration.Top)
+	assert.Equal(t, "0", concentration.DisplayValue)
+
+	// At a past block, above a minimum balance and by address
+	atBlock := uint64(3)
+	holders, err = token.GetHolders(context.Background(), &atBlock, &TokenHoldersOptions{
+		MinBalance: big.NewInt(100),
+		Order:      TokenHoldersByAddress,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "1000", holders.TotalSupply.String())
+	assert.Equal(t, 3, holders.HolderCount)
+	assert.Equal(t, []string{
+		common.HexToAddress(secondaryWallet).String(),
+		common.HexToAddress(adminWallet).String(),
+	}, getHolderAddresses(holders))
+
+	// The transfers before the first block are missing
+	_, err = token.GetHolders(context.Background(), nil, &TokenHoldersOptions{FromBlock: 2})
+	assert.NotNil(t, err)
+}
+
+func TestTokenHoldersRetriesReorgedBlock(t *testing.T) {
+	server := newMockHoldersServer(t, []types.Log{
+		newMockERC20Transfer(t, 1, zeroAddress, adminWallet, 1000),
+	})
+	defer server.Close()
+
+	// The block changes while the first transfers are fetched
+	server.handle("eth_getBlockByNumber", func(params []json.RawMessage) (interface{}, error) {
+		header := mockHeader(10, 10)
+		if server.callCount("eth_getBlockByNumber") == 1 {
+			header.Time = 1
+		}
+		return header, nil
+	})
+
+	helper := server.helper(t)
+	token, err := newToken(helper.GetProvider(), helper.getAddress(), helper.ProviderHandler, nil)
+	assert.Nil(t, err)
+
+	holders, err := token.GetHolders(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, server.callCount("eth_getBlockByNumber"))
+	assert.Equal(t, 2, server.callCount("eth_getLogs"))
+	assert.Equal(t, mockHeader(10, 10).Hash().String(), holders.BlockHash)
+	assert.Equal(t, "1000", holders.Holders[0].Balance.String())
+}
+
+func TestTokenHoldersExport(t *testing.T) {
+	holders, err := newTokenHolders(map[common.Address]*big.Int{
+		common.HexToAddress(adminWallet):     big.NewInt(150),
+		common.HexToAddress(secondaryWallet): big.NewInt(50),
+	}, 10, common.Hash{}, 2, TokenHoldersOptions{})
+	assert.Nil(t, err)
+
+	csv := &bytes.Buffer{}
+	assert.Nil(t, holders.WriteCSV(csv))
+	assert.Equal(t, strings.Join([]string{
+		"address,balance,display_value,share",
+		common.HexToAddress(adminWallet).String() + ",150,1.5,0.75",
+		common.HexToAddress(secondaryWallet).String() + ",50,0.5,0.25",
+		"",
+	}, "\n"), csv.String())
+
+	output := &bytes.Buffer{}
+	assert.Nil(t, holders.WriteJSON(output))
+
+	exported := struct {
+		BlockNumber uint64 `json:"blockNumber"`
+		TotalSupply string `json:"totalSupply"`
+		Holders     []struct {
+			Balance      string `json:"balance"`
+			DisplayValue string `json:"displayValue"`
+		} `json:"holders"`
+	}{}
+	assert.Nil(t, json.Unmarshal(output.Bytes(), &exported))
+	assert.Equal(t, uint64(10), exported.BlockNumber)
+	assert.Equal(t, "200", exported.TotalSupply)
+	assert.Equal(t, "150", exported.Holders[0].Balance)
+	assert.Equal(t, "0.5", exported.Holders[1].DisplayValue)
+}
@@ -0,0 +1,278 @@
+package web3sdks
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// Nodes only accept a replacement transaction if its fees are at least 10% higher
+	minReplacementFeeMultiplier = 1.1
+	cancelTxGasLimit            = 21000
+)
+
+// Policy to automatically speed up a transaction that stays pending for too long while waiting
+// for it, set with the Replacement field of the TxWaitOptions.
+type TxReplacementPolicy struct {
+	// How long a transaction can stay pending before it is replaced
+	After time.Duration
+	// Multiplier applied to the fees of the pending transaction, defaults to and must be at least 1.1
+	Multiplier float64
+	// Maximum number of times the transaction is replaced, defaults to 3
+	MaxReplacements int
+}
+
+// Speed up a pending transaction by sending it again with the same nonce and higher fees.
+//
+// hash: the hash of the pending transaction
+//
+// multiplier: the multiplier applied to the fees of the pending transaction, must be at least 1.1
+//
+// returns: the replacement transaction, which can be waited for with AwaitTx
+//
+// Example
+//
+//	tx, err := sdk.SpeedUp(context.Background(), pendingTx.Hash(), 1.5)
+//	result, err := sdk.AwaitTx(context.Background(), tx.Hash())
+func (handler *ProviderHandler) SpeedUp(ctx context.Context, hash common.Hash, multiplier float64) (*types.Transaction, error) {
+	tx, err := handler.getPendingTx(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.replaceTx(ctx, tx, tx.To(), tx.Value(), tx.Data(), tx.Gas(), tx.AccessList(), multiplier)
+}
+
+// Cancel a pending transaction by replacing it with a zero value transfer to the signer with
+// the same nonce and higher fees. A transaction dropped by the node can't be found by its hash
+// anymore, and must be cancelled with CancelNonce instead.
+//
+// hash: the hash of the pending transaction
+//
+// returns: the cancellation transaction, which can be waited for with AwaitTx
+//
+// Example
+//
+//	tx, err := sdk.Cancel(context.Background(), pendingTx.Hash())
+//	result, err := sdk.AwaitTx(context.Background(), tx.Hash())
+func (handler *ProviderHandler) Cancel(ctx context.Context, hash common.Hash) (*types.Transaction, error) {
+	tx, err := handler.getPendingTx(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	// The access list is dropped since its intrinsic gas wouldn't fit in the gas limit of a transfer
+	to := handler.GetSignerAddress()
+	return handler.replaceTx(ctx, tx, &to, big.NewInt(0), nil, cancelTxGasLimit, nil, minReplacementFeeMultiplier)
+}
+
+// Cancel the pending transaction with the given nonce sent through this handler, or fill the nonce
+// with a zero value transfer to the signer if the node dropped its transaction, so that it doesn't
+// block the later transactions of the signer. Unlike Cancel, this works for transactions the node
+// doesn't know about anymore.
+//
+// nonce: the nonce of the transaction to cancel
+//
+// returns: the cancellation transaction, which can be waited for with AwaitTx
+//
+// Example
+//
+//	tx, err := sdk.CancelNonce(context.Background(), 12)
+//	result, err := sdk.AwaitTx(context.Background(), tx.Hash())
+func (handler *ProviderHandler) CancelNonce(ctx context.Context, nonce uint64) (*types.Transaction, error) {
+	if handler.signer == nil {
+		return nil, ErrNoSigner
+	}
+
+	to := handler.GetSignerAddress()
+	if hash, ok := handler.nonces.getSentHash(to, nonce); ok {
+		if tx, isPending, err := handler.GetProvider().TransactionByHash(ctx, hash); err == nil && isPending {
+			return handler.replaceTx(ctx, tx, &to, big.NewInt(0), nil, cancelTxGasLimit, nil, minReplacementFeeMultiplier)
+		}
+	}
+
+	fees, err := handler.getGasFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	chainId, err := handler.GetChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx *types.Transaction
+	if fees.GasFeeCap != nil {
+		tx = types.NewTx(&types.DynamicFeeTx{
+			ChainID:   chainId,
+			Nonce:     nonce,
+			GasTipCap: fees.GasTipCap,
+			GasFeeCap: fees.GasFeeCap,
+			Gas:       cancelTxGasLimit,
+			To:        &to,
+			Value:     big.NewInt(0),
+		})
+	} else {
+		tx = types.NewTx(&types.LegacyTx{
+			Nonce:    nonce,
+			GasPrice: fees.GasPrice,
+			Gas:      cancelTxGasLimit,
+			To:       &to,
+			Value:    big.NewInt(0),
+		})
+	}
+
+	signedTx, err := handler.signer.SignTx(ctx, tx, chainId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := handler.sendTransaction(ctx, signedTx); err != nil {
+		return nil, err
+	}
+
+	return signedTx, nil
+}
+
+func (handler *ProviderHandler) getPendingTx(ctx context.Context, hash common.Hash) (*types.Transaction, error) {
+	if handler.signer == nil {
+		return nil, ErrNoSigner
+	}
+
+	tx, isPending, err := handler.GetProvider().TransactionByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	if !isPending {
+		return nil, fmt.Errorf("Transaction %s is not pending anymore and can't be replaced", hash.String())
+	}
+
+	sender, err := getTxSender(tx)
+	if err != nil {
+		return nil, err
+	}
+	if sender != handler.GetSignerAddress() {
+		return nil, fmt.Errorf("Transaction %s was sent by %s and can't be replaced by %s", hash.String(), sender.String(), handler.GetSignerAddress().String())
+	}
+
+	return tx, nil
+}
+
+// Sign and send a transaction with the nonce of the original transaction and fees raised by the
+// multiplier, or to the current fees of the fee strategy if those are higher.
+func (handler *ProviderHandler) replaceTx(
+	ctx context.Context,
+	original *types.Transaction,
+	to *common.Address,
+	value *big.Int,
+	data []byte,
+	gas uint64,
+	accessList types.AccessList,
+	multiplier float64,
+) (*types.Transaction, error) {
+	if multiplier < minReplacementFeeMultiplier {
+		return nil, fmt.Errorf("Fee multiplier must be at least %v for the replacement to be accepted, got %v", minReplacementFeeMultiplier, multiplier)
+	}
+
+	fees, err := handler.getGasFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	chainId, err := handler.GetChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var replacement *types.Transaction
+	switch original.Type() {
+	case types.DynamicFeeTxType:
+		replacement = types.NewTx(&types.DynamicFeeTx{
+			ChainID:    chainId,
+			Nonce:      original.Nonce(),
+			GasTipCap:  maxBig(multiplyFee(original.GasTipCap(), multiplier), fees.GasTipCap),
+			GasFeeCap:  maxBig(multiplyFee(original.GasFeeCap(), multiplier), fees.GasFeeCap),
+			Gas:        gas,
+			To:         to,
+			Value:      value,
+			Data:       data,
+			AccessList: accessList,
+		})
+	case types.AccessListTxType:
+		replacement = types.NewTx(&types.AccessListTx{
+			ChainID:    chainId,
+			Nonce:      original.Nonce(),
+			GasPrice:   maxBig(multiplyFee(original.GasPrice(), multiplier), fees.GasPrice),
+			Gas:        gas,
+			To:         to,
+			Value:      value,
+			Data:       data,
+			AccessList: accessList,
+		})
+	default:
+		replacement = types.NewTx(&types.LegacyTx{
+			Nonce:    original.Nonce(),
+			GasPrice: maxBig(multiplyFee(original.GasPrice(), multiplier), fees.GasPrice),
+			Gas:      gas,
+			To:       to,
+			Value:    value,
+			Data:     data,
+		})
+	}
+
+	if handler.feeStrategy != nil {
+		if maxFeeCap := handler.feeStrategy.MaxFeeCap(); maxFeeCap != nil && replacement.GasFeeCap().Cmp(maxFeeCap) > 0 {
+			return nil, &MaxFeeExceededError{
+				RequiredFeePerGas: replacement.GasFeeCap(),
+				MaxFeePerGas:      maxFeeCap,
+			}
+		}
+	}
+
+	signedTx, err := handler.signer.SignTx(ctx, replacement, chainId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := handler.sendTransaction(ctx, signedTx); err != nil {
+		return nil, err
+	}
+
+	return signedTx, nil
+}
+
+func (policy *TxReplacementPolicy) withDefaults() *TxReplacementPolicy {
+	filled := *policy
+	if filled.Multiplier == 0 {
+		filled.Multiplier = minReplacementFeeMultiplier
+	}
+	if filled.MaxReplacements == 0 {
+		filled.MaxReplacements = 3
+	}
+
+	return &filled
+}
+
+// Multiply a fee, rounding up so that the minimum bump is always met
+func multiplyFee(fee *big.Int, multiplier float64) *big.Int {
+	product := big.NewFloat(0).Mul(big.NewFloat(0).SetInt(fee), big.NewFloat(multiplier))
+	result, accuracy := product.Int(nil)
+	if accuracy == big.Below {
+		result.Add(result, big.NewInt(1))
+	}
+
+	return result
+}
+
+func maxBig(a *big.Int, b *big.Int) *big.Int {
+	if b == nil || a.Cmp(b) >= 0 {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,273 @@
+package web3sdks
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// JSON of a transaction as returned by eth_getTransactionByHash while still in the mempool
+func mockPendingTxJson(tx *types.Transaction) map[string]interface{} {
+	fields := mockMinedTxJson(tx, 0)
+	delete(fields, "blockNumber")
+	delete(fields, "blockHash")
+
+	return fields
+}
+
+// Mock server that tracks the transactions sent to it, all of which stay pending
+func newReplacementMockRpcServer(t *testing.T, original *types.Transaction) (*mockRpcServer, func() []*types.Transaction) {
+	server := newMockRpcServer()
+
+	mu := sync.Mutex{}
+	pending := map[common.Hash]*types.Transaction{original.Hash(): original}
+	sent := []*types.Transaction{}
+
+	server.handle("eth_chainId", func(params []json.RawMessage) (interface{}, error) {
+		return "0x1", nil
+	})
+	server.handle("eth_getBlockByNumber", func(params []json.RawMessage) (interface{}, error) {
+		return mockHeader(10, 10), nil
+	})
+	server.handle("eth_getTransactionByHash", func(params []json.RawMessage) (interface{}, error) {
+		var hash common.Hash
+		json.Unmarshal(params[0], &hash)
+
+		mu.Lock()
+		defer mu.Unlock()
+		if tx, ok := pending[hash]; ok {
+			return mockPendingTxJson(tx), nil
+		}
+		return nil, nil
+	})
+	server.handle("eth_sendRawTransaction", func(params []json.RawMessage) (interface{}, error) {
+		var raw hexutil.Bytes
+		json.Unmarshal(params[0], &raw)
+
+		tx := &types.Transaction{}
+		assert.Nil(t, tx.UnmarshalBinary(raw))
+
+		mu.Lock()
+		defer mu.Unlock()
+		pending[tx.Hash()] = tx
+		sent = append(sent, tx)
+		return tx.Hash(), nil
+	})
+
+	getSent := func() []*types.Transaction {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]*types.Transaction{}, sent...)
+	}
+
+	return server, getSent
+}
+
+func TestSpeedUpRaisesFees(t *testing.T) {
+	original := newMockSignedTx(t)
+	server, getSent := newReplacementMockRpcServer(t, original)
+	defer server.Close()
+
+	replacement, err := server.helper(t).SpeedUp(context.Background(), original.Hash(), 1.5)
+	assert.Nil(t, err)
+
+	sent := getSent()
+	assert.Equal(t, 1, len(sent))
+	assert.Equal(t, replacement.Hash(), sent[0].Hash())
+
+	assert.Equal(t, original.Nonce(), replacement.Nonce())
+	assert.Equal(t, original.Data(), replacement.Data())
+	assert.Equal(t, original.To(), replacement.To())
+	assert.Equal(t, original.Gas(), replacement.Gas())
+	// The bumped tip of 3 is lower than the default tip of the fee strategy
+	assert.Equal(t, defaultGasTipCap, replacement.GasTipCap())
+	// The bumped fee cap of 150 is lower than the one of the fee strategy
+	assert.Equal(t, int64(2*10+defaultGasTipCap.Int64()), replacement.GasFeeCap().Int64())
+}
+
+func TestSpeedUpRejectsLowMultiplier(t *testing.T) {
+	original := newMockSignedTx(t)
+	server, getSent := newReplacementMockRpcServer(t, original)
+	defer server.Close()
+
+	_, err := server.helper(t).SpeedUp(context.Background(), original.Hash(), 1.05)
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, len(getSent()))
+}
+
+// Fee strategy wrapping another one, like a strategy adding logging to the fee strategy of an app
+type wrappedFeeStrategy struct {
+	strategy FeeStrategy
+}
+
+func (strategy *wrappedFeeStrategy) GetFees(ctx context.Context, handler *ProviderHandler, baseFee *big.Int) (*GasFees, error) {
+	return strategy.strategy.GetFees(ctx, handler, baseFee)
+}
+
+func (strategy *wrappedFeeStrategy) MaxFeeCap() *big.Int {
+	return strategy.strategy.MaxFeeCap()
+}
+
+func TestSpeedUpRespectsWrappedFeeCeiling(t *testing.T) {
+	original := newMockSignedTx(t)
+	server, getSent := newReplacementMockRpcServer(t, original)
+	defer server.Close()
+
+	// The fees of new transactions are below the ceiling, but not the bumped fee cap of 150
+	helper := server.helper(t)
+	helper.SetFeeStrategy(&wrappedFeeStrategy{&MaxFeeCeilingStrategy{
+		Strategy:     &FixedFeeStrategy{GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(50)},
+		MaxFeePerGas: big.NewInt(120),
+	}})
+
+	_, err := helper.SpeedUp(context.Background(), original.Hash(), 1.5)
+	maxFeeErr := &MaxFeeExceededError{}
+	assert.ErrorAs(t, err, &maxFeeErr)
+	assert.Equal(t, int64(120), maxFeeErr.MaxFeePerGas.Int64())
+	assert.Equal(t, 0, len(getSent()))
+}
+
+func TestCancelSendsSelfTransfer(t *testing.T) {
+	original := newMockSignedTx(t)
+	server, getSent := newReplacementMockRpcServer(t, original)
+	defer server.Close()
+
+	helper := server.helper(t)
+	cancellation, err := helper.Cancel(context.Background(), original.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(getSent()))
+
+	assert.Equal(t, original.Nonce(), cancellation.Nonce())
+	assert.Equal(t, helper.GetSignerAddress(), *cancellation.To())
+	assert.Equal(t, int64(0), cancellation.Value().Int64())
+	assert.Equal(t, 0, len(cancellation.Data()))
+	assert.Equal(t, uint64(cancelTxGasLimit), cancellation.Gas())
+}
+
+func TestCancelDropsAccessList(t *testing.T) {
+	signer, _ := NewPrivateKeySigner(adminPrivateKey)
+	to := common.HexToAddress(secondaryWallet)
+	original, err := signer.SignTx(context.Background(), types.NewTx(&types.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		Nonce:     3,
+		GasTipCap: big.NewInt(2),
+		GasFeeCap: big.NewInt(100),
+		Gas:       100000,
+		To:        &to,
+		Data:      []byte{0x01, 0x02, 0x03, 0x04},
+		AccessList: types.AccessList{
+			{Address: to, StorageKeys: []common.Hash{common.HexToHash("0x01")}},
+		},
+	}), big.NewInt(1))
+	assert.Nil(t, err)
+
+	server, getSent := newReplacementMockRpcServer(t, original)
+	defer server.Close()
+
+	// An access list costs intrinsic gas on top of the 21000 of a transfer, so the node would
+	// reject a cancellation keeping it
+	cancellation, err := server.helper(t).Cancel(context.Background(), original.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(getSent()))
+	assert.Equal(t, uint8(types.DynamicFeeTxType), cancellation.Type())
+	assert.Equal(t, original.Nonce(), cancellation.Nonce())
+	assert.Equal(t, 0, len(cancellation.AccessList()))
+	assert.Equal(t, uint64(cancelTxGasLimit), cancellation.Gas())
+}
+
+func TestCancelNonceReplacesPendingTx(t *testing.T) {
+	original := newMockSignedTx(t)
+	server, getSent := newReplacementMockRpcServer(t, original)
+	defer server.Close()
+	server.handle("eth_getTransactionCount", func(params []json.RawMessage) (interface{}, error) {
+		return hexutil.Uint64(0), nil
+	})
+
+	helper := server.helper(t)
+	account := helper.GetSignerAddress()
+	nonce, err := helper.nonces.acquire(context.Background(), helper.ProviderHandler, account)
+	assert.Nil(t, err)
+	helper.nonces.markSent(account, nonce, original.Hash())
+
+	cancellation, err := helper.CancelNonce(context.Background(), nonce)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(getSent()))
+	assert.Equal(t, original.Nonce(), cancellation.Nonce())
+	assert.Equal(t, account, *cancellation.To())
+	assert.True(t, cancellation.GasTipCap().Cmp(original.GasTipCap()) > 0)
+	assert.True(t, cancellation.GasFeeCap().Cmp(original.GasFeeCap()) > 0)
+
+	// The nonce is now held by the cancellation
+	hash, ok := helper.nonces.getSentHash(account, nonce)
+	assert.True(t, ok)
+	assert.Equal(t, cancellation.Hash(), hash)
+}
+
+func TestCancelNonceFillsDroppedNonce(t *testing.T) {
+	server, getSent := newReplacementMockRpcServer(t, newMockSignedTx(t))
+	defer server.Close()
+
+	// The node doesn't know about any transaction with this nonce
+	helper := server.helper(t)
+	filler, err := helper.CancelNonce(context.Background(), 4)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(getSent()))
+
+	assert.Equal(t, uint8(types.DynamicFeeTxType), filler.Type())
+	assert.Equal(t, uint64(4), filler.Nonce())
+	assert.Equal(t, helper.GetSignerAddress(), *filler.To())
+	assert.Equal(t, int64(0), filler.Value().Int64())
+	assert.Equal(t, uint64(cancelTxGasLimit), filler.Gas())
+}
+
+func TestAwaitTxReplacesPendingTx(t *testing.T) {
+	original := newMockSignedTx(t)
+	server, getSent := newReplacementMockRpcServer(t, original)
+	defer server.Close()
+
+	// Replacements are mined as soon as they are sent
+	server.handle("eth_getTransactionReceipt", func(params []json.RawMessage) (interface{}, error) {
+		var hash common.Hash
+		json.Unmarshal(params[0], &hash)
+
+		for _, tx := range getSent() {
+			if tx.Hash() == hash {
+				return mockReceipt(tx, 11, types.ReceiptStatusSuccessful), nil
+			}
+		}
+		return nil, nil
+	})
+	getTx := server.handlers["eth_getTransactionByHash"]
+	server.handle("eth_getTransactionByHash", func(params []json.RawMessage) (interface{}, error) {
+		var hash common.Hash
+		json.Unmarshal(params[0], &hash)
+
+		for _, tx := range getSent() {
+			if tx.Hash() == hash {
+				return mockMinedTxJson(tx, 11), nil
+			}
+		}
+		return getTx(params)
+	})
+
+	ctx := WithTxWaitOptions(context.Background(), &TxWaitOptions{
+		PollInterval: time.Millisecond * 10,
+		Timeout:      time.Second * 5,
+		Replacement:  &TxReplacementPolicy{After: time.Millisecond * 30},
+	})
+	result, err := server.helper(t).AwaitTx(ctx, original.Hash())
+	assert.Nil(t, err)
+
+	sent := getSent()
+	assert.Equal(t, 1, len(sent))
+	assert.Equal(t, sent[0].Hash(), result.Hash())
+	assert.Equal(t, original.Nonce(), result.Nonce())
+}
@@ -0,0 +1,167 @@
+package web3sdks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// The type of transaction sent by the SDK, set with the TransactionType field of the SDKOptions.
+type TransactionType int
+
+const (
+	// Use dynamic fee transactions if the chain supports them, and legacy transactions otherwise.
+	// If CreateAccessList is set, access list transactions are used instead of legacy transactions.
+	TransactionTypeAuto TransactionType = iota
+	// Legacy transactions with a gas price
+	TransactionTypeLegacy
+	// EIP-2930 access list transactions with a gas price
+	TransactionTypeAccessList
+	// EIP-1559 dynamic fee transactions
+	TransactionTypeDynamicFee
+)
+
+func (txType TransactionType) String() string {
+	switch txType {
+	case TransactionTypeAuto:
+		return "auto"
+	case TransactionTypeLegacy:
+		return "legacy"
+	case TransactionTypeAccessList:
+		return "access list"
+	case TransactionTypeDynamicFee:
+		return "dynamic fee"
+	default:
+		return fmt.Sprintf("unknown (%d)", int(txType))
+	}
+}
+
+type createAccessListResult struct {
+	AccessList *types.AccessList `json:"accessList"`
+	GasUsed    hexutil.Uint64    `json:"gasUsed"`
+	Error      string            `json:"error,omitempty"`
+}
+
+// Resolve the transaction type to use given the latest block header of the chain
+func (handler *ProviderHandler) resolveTransactionType(header *types.Header) (TransactionType, error) {
+	switch handler.txType {
+	case TransactionTypeAuto:
+		if header.BaseFee != nil {
+			return TransactionTypeDynamicFee, nil
+		} else if handler.createAccessList {
+			return TransactionTypeAccessList, nil
+		}
+
+		return TransactionTypeLegacy, nil
+	case TransactionTypeDynamicFee:
+		if header.BaseFee == nil {
+			return 0, errors.New("Dynamic fee transactions are not supported by this chain, use legacy or access list transactions instead")
+		}
+
+		return TransactionTypeDynamicFee, nil
+	case TransactionTypeLegacy, TransactionTypeAccessList:
+		return handler.txType, nil
+	default:
+		return 0, fmt.Errorf("Unsupported transaction type %s", handler.txType.String())
+	}
+}
+
+// Convert a transaction built by the contract bindings to the configured transaction type, and
+// attach a generated access list if enabled. The bindings only build legacy and dynamic fee
+// transactions, so access list transactions are converted from legacy ones here. The chain ID is
+// fetched from the provider if nil.
+func (handler *ProviderHandler) prepareTx(ctx context.Context, from common.Address, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error) {
+	isLegacy := tx.Type() == types.LegacyTxType
+	toAccessListTx := isLegacy && (handler.txType == TransactionTypeAccessList ||
+		(handler.txType == TransactionTypeAuto && handler.createAccessList))
+	if !toAccessListTx && !(handler.createAccessList && !isLegacy) {
+		return tx, nil
+	}
+
+	accessList := types.AccessList{}
+	gas := tx.Gas()
+	if handler.createAccessList {
+		result, err := handler.createAccessListForTx(ctx, from, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		if result.AccessList != nil {
+			accessList = *result.AccessList
+		}
+		// Including an access list changes the gas used by the transaction
+		if uint64(result.GasUsed) > gas {
+			gas = uint64(result.GasUsed)
+		}
+	}
+
+	if chainId == nil {
+		var err error
+		if chainId, err = handler.GetChainID(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if tx.Type() == types.DynamicFeeTxType {
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:    chainId,
+			Nonce:      tx.Nonce(),
+			GasTipCap:  tx.GasTipCap(),
+			GasFeeCap:  tx.GasFeeCap(),
+			Gas:        gas,
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: accessList,
+		}), nil
+	}
+
+	return types.NewTx(&types.AccessListTx{
+		ChainID:    chainId,
+		Nonce:      tx.Nonce(),
+		GasPrice:   tx.GasPrice(),
+		Gas:        gas,
+		To:         tx.To(),
+		Value:      tx.Value(),
+		Data:       tx.Data(),
+		AccessList: accessList,
+	}), nil
+}
+
+func (handler *ProviderHandler) createAccessListForTx(ctx context.Context, from common.Address, tx *types.Transaction) (*createAccessListResult, error) {
+	rpcClient := handler.GetRpcClient()
+	if rpcClient == nil {
+		return nil, errors.New("Creating access lists requires the SDK to be created from an RPC URL or RPC client")
+	}
+
+	args := map[string]interface{}{
+		"from":  from,
+		"gas":   hexutil.Uint64(tx.Gas()),
+		"value": (*hexutil.Big)(tx.Value()),
+		"data":  hexutil.Bytes(tx.Data()),
+	}
+	if tx.To() != nil {
+		args["to"] = tx.To()
+	}
+	if tx.Type() == types.DynamicFeeTxType {
+		args["maxFeePerGas"] = (*hexutil.Big)(tx.GasFeeCap())
+		args["maxPriorityFeePerGas"] = (*hexutil.Big)(tx.GasTipCap())
+	} else {
+		args["gasPrice"] = (*hexutil.Big)(tx.GasPrice())
+	}
+
+	result := &createAccessListResult{}
+	if err := rpcClient.CallContext(ctx, result, "eth_createAccessList", args, "pending"); err != nil {
+		return nil, err
+	}
+	if result.Error != "" {
+		return nil, fmt.Errorf("Failed to create access list: %s", result.Error)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,120 @@
+package web3sdks
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func newPreLondonMockRpcServer() *mockRpcServer {
+	server := newMockRpcServer()
+	server.handle("eth_chainId", func(params []json.RawMessage) (interface{}, error) {
+		return hexutil.Uint64(1), nil
+	})
+	server.handle("eth_getBlockByNumber", func(params []json.RawMessage) (interface{}, error) {
+		return &types.Header{Number: big.NewInt(10), Difficulty: big.NewInt(0)}, nil
+	})
+	server.handle("eth_gasPrice", func(params []json.RawMessage) (interface{}, error) {
+		return hexutil.Uint64(30), nil
+	})
+
+	return server
+}
+
+func TestAutoTransactionTypeOnLegacyChain(t *testing.T) {
+	server := newPreLondonMockRpcServer()
+	defer server.Close()
+
+	txOpts, err := server.helper(t).GetTxOptions(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(30), txOpts.GasPrice)
+	assert.Nil(t, txOpts.GasTipCap)
+	assert.Nil(t, txOpts.GasFeeCap)
+}
+
+func TestLegacyTransactionTypeOnDynamicFeeChain(t *testing.T) {
+	server := newFeeMockRpcServer(100)
+	defer server.Close()
+	server.handle("eth_gasPrice", func(params []json.RawMessage) (interface{}, error) {
+		return hexutil.Uint64(150), nil
+	})
+
+	helper := server.helper(t)
+	helper.SetTransactionType(TransactionTypeLegacy)
+
+	txOpts, err := helper.GetTxOptions(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(150), txOpts.GasPrice)
+	assert.Nil(t, txOpts.GasTipCap)
+
+	helper.SetFeeStrategy(&FixedFeeStrategy{GasPrice: big.NewInt(120)})
+	txOpts, err = helper.GetTxOptions(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(120), txOpts.GasPrice)
+}
+
+func TestDynamicFeeTransactionTypeOnLegacyChain(t *testing.T) {
+	server := newPreLondonMockRpcServer()
+	defer server.Close()
+
+	helper := server.helper(t)
+	helper.SetTransactionType(TransactionTypeDynamicFee)
+
+	_, err := helper.GetTxOptions(context.Background())
+	assert.NotNil(t, err)
+}
+
+func TestAccessListTransactionType(t *testing.T) {
+	server := newPreLondonMockRpcServer()
+	defer server.Close()
+
+	storageKey := common.HexToHash("0x01")
+	server.handle("eth_createAccessList", func(params []json.RawMessage) (interface{}, error) {
+		args := map[string]interface{}{}
+		json.Unmarshal(params[0], &args)
+		assert.Equal(t, "0x1e", args["gasPrice"])
+
+		return map[string]interface{}{
+			"accessList": types.AccessList{{Address: common.HexToAddress(secondaryWallet), StorageKeys: []common.Hash{storageKey}}},
+			"gasUsed":    hexutil.Uint64(60000),
+		}, nil
+	})
+
+	server.handle("eth_getTransactionCount", func(params []json.RawMessage) (interface{}, error) {
+		return hexutil.Uint64(3), nil
+	})
+
+	helper := server.helper(t)
+	helper.SetTransactionType(TransactionTypeAccessList)
+	helper.SetCreateAccessList(true)
+
+	txOpts, err := helper.GetTxOptions(context.Background())
+	assert.Nil(t, err)
+
+	to := common.HexToAddress(secondaryWallet)
+	tx, err := txOpts.Signer(txOpts.From, types.NewTx(&types.LegacyTx{
+		Nonce:    1,
+		GasPrice: txOpts.GasPrice,
+		Gas:      50000,
+		To:       &to,
+	}))
+	assert.Nil(t, err)
+	assert.Equal(t, uint8(types.AccessListTxType), tx.Type())
+	assert.Equal(t, big.NewInt(1), tx.ChainId())
+	// The nonce is assigned by the nonce manager
+	assert.Equal(t, uint64(3), tx.Nonce())
+	assert.Equal(t, big.NewInt(30), tx.GasPrice())
+	// The gas is raised to what the access list transaction uses
+	assert.Equal(t, uint64(60000), tx.Gas())
+	assert.Equal(t, []common.Hash{storageKey}, tx.AccessList()[0].StorageKeys)
+
+	sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	assert.Nil(t, err)
+	assert.Equal(t, adminWallet, sender.String())
+}
@@ -0,0 +1,313 @@
+package web3sdks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	defaultTxPollInterval = time.Second * 1
+	defaultTxMaxAttempts  = 20
+)
+
+// Options controlling how the SDK waits for transactions to be mined. They can be set for
+// every transaction of an SDK with the TxWait field of the SDKOptions, or for a single call
+// with WithTxWaitOptions.
+type TxWaitOptions struct {
+	// Number of blocks, including the one the transaction was mined in, before the transaction is
+	// considered final. Defaults to 1
+	Confirmations uint64
+	// Time between checks for the transaction, defaults to 1 second
+	PollInterval time.Duration
+	// Maximum time to wait for the transaction. If 0, the SDK waits until the context is done
+	Timeout time.Duration
+	// Number of consecutive failed requests to the provider before giving up, defaults to 20
+	MaxAttempts int
+	// Wait for new blocks using an eth_subscribe newHeads subscription instead of polling. Only
+	// supported by websocket providers, falls back to polling if the subscription fails
+	SubscribeNewHeads bool
+	// Automatically speed up the transaction if it stays pending for too long, disabled if nil
+	Replacement *TxReplacementPolicy
+}
+
+type txWaitOptionsKey struct{}
+
+// Override the transaction wait options for any transaction sent with the returned context.
+//
+// ctx: the parent context
+//
+// options: the wait options to use for transactions sent with the context
+//
+// returns: a context carrying the wait options
+//
+// Example
+//
+//	ctx := web3sdks.WithTxWaitOptions(context.Background(), &web3sdks.TxWaitOptions{
+//		Confirmations: 3,
+//		Timeout:       time.Minute * 5,
+//	})
+//	tx, err := contract.Mint(ctx, metadata)
+func WithTxWaitOptions(ctx context.Context, options *TxWaitOptions) context.Context {
+	return context.WithValue(ctx, txWaitOptionsKey{}, options)
+}
+
+func (options *TxWaitOptions) withDefaults() *TxWaitOptions {
+	filled := TxWaitOptions{}
+	if options != nil {
+		filled = *options
+	}
+
+	if filled.Confirmations == 0 {
+		filled.Confirmations = 1
+	}
+	if filled.PollInterval <= 0 {
+		filled.PollInterval = defaultTxPollInterval
+	}
+	if filled.MaxAttempts <= 0 {
+		filled.MaxAttempts = defaultTxMaxAttempts
+	}
+	if filled.Replacement != nil {
+		filled.Replacement = filled.Replacement.withDefaults()
+	}
+
+	return &filled
+}
+
+// Wait for a transaction to be mined with the required number of confirmations.
+//
+// hash: the hash of the transaction to wait for
+//
+// returns: the transaction along with its receipt, or a *TransactionRevertedError if the transaction
+// reverted. If the transaction was replaced by the replacement policy of the wait options, the result
+// is the replacement transaction that was mined.
+//
+// Example
+//
+//	result, err := sdk.AwaitTx(context.Background(), tx.Hash())
+//	fmt.Println(result.Receipt.BlockNumber)
+func (handler *ProviderHandler) AwaitTx(ctx context.Context, hash common.Hash) (*TransactionResult, error) {
+	options := handler.getTxWaitOptions(ctx)
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
+	}
+
+	waitForNextCheck, stop := handler.newTxWaitTicker(ctx, options)
+	defer stop()
+
+	provider := handler.GetProvider()
+	attempts := 0
+
+	// The original transaction and all its replacements, any of which can end up mined
+	hashes := []common.Hash{hash}
+	lastSent := time.Now()
+
+	// The state changes once a transaction is mined, so the read cache is invalidated when its
+	// receipt is first seen, and again if a reorg moves it to another block
+	var invalidatedBlock *big.Int
+	invalidateCache := func(receipt *types.Receipt) {
+		if invalidatedBlock == nil || invalidatedBlock.Cmp(receipt.BlockNumber) != 0 {
+			handler.InvalidateCache()
+			invalidatedBlock = receipt.BlockNumber
+		}
+	}
+
+	var syncError error
+	for {
+		if attempts >= options.MaxAttempts {
+			return nil, fmt.Errorf("Retry attempts to get tx %s exhausted, tx might have failed: %w", hash.String(), syncError)
+		}
+
+		mined := false
+		failed := 0
+		for i := len(hashes) - 1; i >= 0; i-- {
+			result, final, err := handler.checkTx(ctx, hashes[i], options.Confirmations)
+			if err != nil {
+				var revertErr *TransactionRevertedError
+				if errors.As(err, &revertErr) {
+					invalidateCache(revertErr.Receipt)
+					return nil, err
+				}
+				if ctx.Err() != nil {
+					return nil, err
+				}
+
+				syncError = err
+				log.Printf("Failed to get tx %v, err = %v\n", hashes[i].String(), err)
+				failed += 1
+			} else if final {
+				invalidateCache(result.Receipt)
+				log.Printf("Transaction with hash %v mined successfully\n", hashes[i].String())
+				result.EffectiveGasPrice = getEffectiveGasPrice(ctx, provider, result.Transaction, result.Receipt.BlockNumber)
+				return result, nil
+			} else if result != nil {
+				invalidateCache(result.Receipt)
+				mined = true
+				break
+			}
+		}
+
+		if failed == len(hashes) {
+			attempts += 1
+		} else {
+			attempts = 0
+		}
+
+		if policy := options.Replacement; !mined && failed < len(hashes) && policy != nil && time.Since(lastSent) >= policy.After && len(hashes) <= policy.MaxReplacements {
+			latest := hashes[len(hashes)-1]
+			if replacement, err := handler.SpeedUp(ctx, latest, policy.Multiplier); err != nil {
+				log.Printf("Failed to replace tx %v, err = %v\n", latest.String(), err)
+			} else {
+				log.Printf("Replaced pending tx %v with tx %v\n", latest.String(), replacement.Hash().String())
+				hashes = append(hashes, replacement.Hash())
+			}
+			lastSent = time.Now()
+		}
+
+		if err := waitForNextCheck(); err != nil {
+			return nil, fmt.Errorf("Stopped waiting for tx %s: %w", hash.String(), err)
+		}
+	}
+}
+
+func (handler *ProviderHandler) getTxWaitOptions(ctx context.Context) *TxWaitOptions {
+	if options, ok := ctx.Value(txWaitOptionsKey{}).(*TxWaitOptions); ok && options != nil {
+		return options.withDefaults()
+	}
+
+	return handler.txWaitOptions.withDefaults()
+}
+
+// Check the state of a transaction once. Returns nil if the transaction is still pending, and the
+// result along with whether the transaction has enough confirmations once it's mined.
+func (handler *ProviderHandler) checkTx(ctx context.Context, hash common.Hash, confirmations uint64) (*TransactionResult, bool, error) {
+	provider := handler.GetProvider()
+
+	tx, isPending, err := provider.TransactionByHash(ctx, hash)
+	if err != nil {
+		return nil, false, err
+	}
+	if isPending {
+		log.Println("Transaction still pending...")
+		return nil, false, nil
+	}
+
+	// The receipt can lag slightly behind the transaction on some nodes
+	receipt, err := provider.TransactionReceipt(ctx, hash)
+	if errors.Is(err, ethereum.NotFound) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+
+	if receipt.Status == types.ReceiptStatusFailed {
+		revertErr := &TransactionRevertedError{
+			Hash:    hash,
+			Receipt: receipt,
+		}
+		revertErr.Reason, revertErr.Revert = getRevertReason(ctx, provider, tx, receipt.BlockNumber)
+
+		return nil, false, revertErr
+	}
+
+	result := &TransactionResult{
+		Transaction: tx,
+		Receipt:     receipt,
+	}
+
+	if confirmations > 1 {
+		head, err := provider.BlockNumber(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+
+		// The receipt is fetched again on every check, so a reorg that moves the transaction
+		// to another block restarts the count
+		if mined := receipt.BlockNumber.Uint64(); head < mined || head-mined+1 < confirmations {
+			log.Printf("Transaction mined, waiting for %d confirmations...\n", confirmations)
+			return result, false, nil
+		}
+	}
+
+	return result, true, nil
+}
+
+// Returns a function that blocks until the transaction should be checked again, along with a
+// function to release the resources of the ticker.
+func (handler *ProviderHandler) newTxWaitTicker(ctx context.Context, options *TxWaitOptions) (func() error, func()) {
+	if options.SubscribeNewHeads {
+		heads := make(chan *types.Header, 1)
+		sub, err := handler.GetProvider().SubscribeNewHead(ctx, heads)
+		if err == nil {
+			wait := func() error {
+				select {
+				case <-heads:
+					return nil
+				case err := <-sub.Err():
+					return err
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+
+			return wait, sub.Unsubscribe
+		}
+
+		log.Printf("Failed to subscribe to new blocks, falling back to polling, err = %v\n", err)
+	}
+
+	ticker := time.NewTicker(options.PollInterval)
+	wait := func() error {
+		select {
+		case <-ticker.C:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return wait, ticker.Stop
+}
+
+// Replay a mined transaction as a call at the block it was mined in to recover the revert reason
+func getRevertReason(ctx context.Context, provider ethereum.ContractCaller, tx *types.Transaction, blockNumber *big.Int) (string, *RevertError) {
+	from, err := getTxSender(tx)
+	if err != nil {
+		return "", nil
+	}
+
+	msg := ethereum.CallMsg{
+		From:  from,
+		To:    tx.To(),
+		Gas:   tx.Gas(),
+		Value: tx.Value(),
+		Data:  tx.Data(),
+	}
+	if _, err := provider.CallContract(ctx, msg, blockNumber); err != nil {
+		if revertErr, ok := DecodeRevertError(err); ok && revertErr.Reason() != "" {
+			return revertErr.Reason(), revertErr
+		}
+
+		return err.Error(), nil
+	}
+
+	return "", nil
+}
+
+func getEffectiveGasPrice(ctx context.Context, provider ethereum.ChainReader, tx *types.Transaction, blockNumber *big.Int) *big.Int {
+	header, err := provider.HeaderByNumber(ctx, blockNumber)
+	if err != nil || header.BaseFee == nil {
+		return tx.GasPrice()
+	}
+
+	return big.NewInt(0).Add(header.BaseFee, tx.EffectiveGasTipValue(header.BaseFee))
+}
@@ -0,0 +1,146 @@
+package web3sdks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAwaitTxReturnsReceipt(t *testing.T) {
+	server := newMockRpcServer()
+	defer server.Close()
+
+	tx := newMockSignedTx(t)
+	server.handle("eth_getTransactionByHash", func(params []json.RawMessage) (interface{}, error) {
+		return mockMinedTxJson(tx, 10), nil
+	})
+	server.handle("eth_getTransactionReceipt", func(params []json.RawMessage) (interface{}, error) {
+		return mockReceipt(tx, 10, types.ReceiptStatusSuccessful), nil
+	})
+	server.handle("eth_getBlockByNumber", func(params []json.RawMessage) (interface{}, error) {
+		return mockHeader(10, 10), nil
+	})
+
+	result, err := server.helper(t).AwaitTx(context.Background(), tx.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, tx.Hash(), result.Hash())
+	assert.Equal(t, types.ReceiptStatusSuccessful, result.Receipt.Status)
+	assert.Equal(t, uint64(50000), result.Receipt.GasUsed)
+	assert.Equal(t, int64(10), result.Receipt.BlockNumber.Int64())
+	// Base fee of 10 plus the tip of 2
+	assert.Equal(t, int64(12), result.EffectiveGasPrice.Int64())
+}
+
+func TestAwaitTxReverted(t *testing.T) {
+	server := newMockRpcServer()
+	defer server.Close()
+
+	tx := newMockSignedTx(t)
+	server.handle("eth_getTransactionByHash", func(params []json.RawMessage) (interface{}, error) {
+		return mockMinedTxJson(tx, 10), nil
+	})
+	server.handle("eth_getTransactionReceipt", func(params []json.RawMessage) (interface{}, error) {
+		return mockReceipt(tx, 10, types.ReceiptStatusFailed), nil
+	})
+	server.handle("eth_call", func(params []json.RawMessage) (interface{}, error) {
+		var block string
+		json.Unmarshal(params[1], &block)
+		assert.Equal(t, "0xa", block)
+
+		return nil, mockRevertError("Not enough supply")
+	})
+
+	result, err := server.helper(t).AwaitTx(context.Background(), tx.Hash())
+	assert.Nil(t, result)
+
+	revertErr := &TransactionRevertedError{}
+	assert.True(t, errors.As(err, &revertErr))
+	assert.Equal(t, tx.Hash(), revertErr.Hash)
+	assert.Equal(t, types.ReceiptStatusFailed, revertErr.Receipt.Status)
+	assert.Equal(t, "Not enough supply", revertErr.Reason)
+}
+
+func TestAwaitTxWaitsForConfirmations(t *testing.T) {
+	server := newMockRpcServer()
+	defer server.Close()
+
+	tx := newMockSignedTx(t)
+	head := uint64(10)
+	server.handle("eth_getTransactionByHash", func(params []json.RawMessage) (interface{}, error) {
+		return mockMinedTxJson(tx, 10), nil
+	})
+	server.handle("eth_getTransactionReceipt", func(params []json.RawMessage) (interface{}, error) {
+		return mockReceipt(tx, 10, types.ReceiptStatusSuccessful), nil
+	})
+	server.handle("eth_blockNumber", func(params []json.RawMessage) (interface{}, error) {
+		return hexutil.Uint64(atomic.AddUint64(&head, 1) - 1), nil
+	})
+	server.handle("eth_getBlockByNumber", func(params []json.RawMessage) (interface{}, error) {
+		return mockHeader(10, 10), nil
+	})
+
+	ctx := WithTxWaitOptions(context.Background(), &TxWaitOptions{
+		Confirmations: 3,
+		PollInterval:  time.Millisecond,
+	})
+	helper := server.helper(t)
+	helper.cache = newReadCache(&CacheOptions{}, helper.ProviderHandler)
+	result, err := helper.AwaitTx(ctx, tx.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, tx.Hash(), result.Hash())
+	// Blocks 10, 11 and 12 are needed for 3 confirmations
+	assert.Equal(t, 3, server.callCount("eth_blockNumber"))
+	// The cache is only invalidated when the receipt is first seen
+	assert.Equal(t, uint64(1), helper.cache.generation)
+}
+
+func TestAwaitTxTimeout(t *testing.T) {
+	server := newMockRpcServer()
+	defer server.Close()
+
+	tx := newMockSignedTx(t)
+	server.handle("eth_getTransactionByHash", func(params []json.RawMessage) (interface{}, error) {
+		// Pending transactions have no block number
+		fields := mockMinedTxJson(tx, 0)
+		delete(fields, "blockNumber")
+		delete(fields, "blockHash")
+		return fields, nil
+	})
+
+	helper := server.helper(t)
+	helper.SetTxWaitOptions(&TxWaitOptions{
+		PollInterval: time.Millisecond * 10,
+		Timeout:      time.Millisecond * 50,
+	})
+
+	start := time.Now()
+	result, err := helper.AwaitTx(context.Background(), tx.Hash())
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+	assert.Equal(t, 0, server.callCount("eth_getTransactionReceipt"))
+}
+
+func TestAwaitTxCancelledContext(t *testing.T) {
+	server := newMockRpcServer()
+	defer server.Close()
+
+	tx := newMockSignedTx(t)
+	server.handle("eth_getTransactionByHash", func(params []json.RawMessage) (interface{}, error) {
+		return nil, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result, err := server.helper(t).AwaitTx(ctx, tx.Hash())
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, context.Canceled))
+}
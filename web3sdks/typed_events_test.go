@@ -0,0 +1,553 @@
+package web3sdks
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/web3sdks/go-sdk/v2/abi"
+)
+
+// Create a log of an event of a contract, with the indexed arguments as topics and the other ones
+// as data, in the order of the event inputs
+func newMockEventLog(t *testing.T, metadata *bind.MetaData, eventName string, blockNumber uint64, args ...interface{}) types.Log {
+	contractAbi, err := metadata.GetAbi()
+	assert.Nil(t, err)
+
+	event := contractAbi.Events[eventName]
+	topics := []common.Hash{event.ID}
+	data := []interface{}{}
+	for i, input := range event.Inputs {
+		if !input.Indexed {
+			data = append(data, args[i])
+			continue
+		}
+
+		topic, err := ethabi.MakeTopics([]interface{}{args[i]})
+		assert.Nil(t, err)
+		topics = append(topics, topic[0][0])
+	}
+
+	packed, err := event.Inputs.NonIndexed().Pack(data...)
+	assert.Nil(t, err)
+
+	return newMockTokenLog(blockNumber, topics, packed)
+}
+
+// Create a contract helper polling the logs of a mock logs service over HTTP
+func newTestLogsHelper(t *testing.T, url string) *contractHelper {
+	client, err := rpc.Dial(url)
+	assert.Nil(t, err)
+
+	helper, err := newContractHelper(common.HexToAddress(secondaryWallet), NewProviderHandlerWithSigner(ethclient.NewClient(client), nil))
+	assert.Nil(t, err)
+
+	return helper
+}
+
+// The typed events of every prebuilt contract, all reading the logs of the contract of the helper
+type testTypedEvents struct {
+	token         *TokenEvents
+	nftCollection *NFTCollectionEvents
+	nftDrop       *NFTDropEvents
+	edition       *EditionEvents
+	editionDrop   *EditionDropEvents
+	marketplace   *MarketplaceEvents
+}
+
+func newTestTypedEvents(t *testing.T, helper *contractHelper) *testTypedEvents {
+	address := helper.getAddress()
+	provider := helper.GetProvider()
+	events := &testTypedEvents{}
+
+	token, err := abi.NewTokenERC20(address, provider)
+	assert.Nil(t, err)
+	events.token, err = newTokenEvents(token, helper)
+	assert.Nil(t, err)
+
+	nftCollection, err := abi.NewTokenERC721(address, provider)
+	assert.Nil(t, err)
+	events.nftCollection, err = newNFTCollectionEvents(nftCollection, helper)
+	assert.Nil(t, err)
+
+	nftDrop, err := abi.NewDropERC721(address, provider)
+	assert.Nil(t, err)
+	events.nftDrop, err = newNFTDropEvents(nftDrop, helper)
+	assert.Nil(t, err)
+
+	edition, err := abi.NewTokenERC1155(address, provider)
+	assert.Nil(t, err)
+	events.edition, err = newEditionEvents(edition, helper)
+	assert.Nil(t, err)
+
+	editionDrop, err := abi.NewDropERC1155(address, provider)
+	assert.Nil(t, err)
+	events.editionDrop, err = newEditionDropEvents(editionDrop, helper)
+	assert.Nil(t, err)
+
+	marketplace, err := abi.NewMarketplace(address, provider)
+	assert.Nil(t, err)
+	events.marketplace, err = newMarketplaceEvents(marketplace, helper)
+	assert.Nil(t, err)
+
+	return events
+}
+
+// An event of a prebuilt contract along with the typed methods querying and listening to it
+type typedEventCase struct {
+	name string
+	// Create a log of the event mined at the given block
+	log func(t *testing.T, blockNumber uint64) types.Log
+	// Query the event, returning a slice of the typed event structs
+	get func(events *testTypedEvents, options EventQueryOptions) (interface{}, error)
+	// Listen to the event, passing the typed event structs to the listener
+	on func(events *testTypedEvents, listener func(event interface{}), options *EventListenerOptions) EventSubscription
+	// Check the fields decoded from the log
+	check func(t *testing.T, event interface{})
+}
+
+var (
+	testEventLister   = common.HexToAddress(adminWallet)
+	testEventReceiver = common.HexToAddress(tertiaryWallet)
+	testEventAsset    = common.HexToAddress(secondaryWallet)
+)
+
+var typedEventCases = []typedEventCase{
+	{
+		name: "TokenTransfer",
+		log: func(t *testing.T, blockNumber uint64) types.Log {
+			return newMockEventLog(t, abi.TokenERC20MetaData, "Transfer", blockNumber, testEventLister, testEventReceiver, big.NewInt(100))
+		},
+		get: func(events *testTypedEvents, options EventQueryOptions) (interface{}, error) {
+			return events.token.GetTransfers(context.Background(), options)
+		},
+		on: func(events *testTypedEvents, listener func(event interface{}), options *EventListenerOptions) EventSubscription {
+			return events.token.OnTransfer(context.Background(), func(event *abi.TokenERC20Transfer) { listener(event) }, options)
+		},
+		check: func(t *testing.T, event interface{}) {
+			transfer := event.(*abi.TokenERC20Transfer)
+			assert.Equal(t, testEventReceiver, transfer.To)
+			assert.Equal(t, int64(100), transfer.Value.Int64())
+		},
+	},
+	{
+		name: "TokenTokensMinted",
+		log: func(t *testing.T, blockNumber uint64) types.Log {
+			return newMockEventLog(t, abi.TokenERC20MetaData, "TokensMinted", blockNumber, testEventReceiver, big.NewInt(50))
+		},
+		get: func(events *testTypedEvents, options EventQueryOptions) (interface{}, error) {
+			return events.token.GetTokensMinted(context.Background(), options)
+		},
+		on: func(events *testTypedEvents, listener func(event interface{}), options *EventListenerOptions) EventSubscription {
+			return events.token.OnTokensMinted(context.Background(), func(event *abi.TokenERC20TokensMinted) { listener(event) }, options)
+		},
+		check: func(t *testing.T, event interface{}) {
+			mint := event.(*abi.TokenERC20TokensMinted)
+			assert.Equal(t, testEventReceiver, mint.MintedTo)
+			assert.Equal(t, int64(50), mint.QuantityMinted.Int64())
+		},
+	},
+	{
+		name: "NFTCollectionTransfer",
+		log: func(t *testing.T, blockNumber uint64) types.Log {
+			return newMockEventLog(t, abi.TokenERC721MetaData, "Transfer", blockNumber, common.Address{}, testEventReceiver, big.NewInt(7))
+		},
+		get: func(events *testTypedEvents, options EventQueryOptions) (interface{}, error) {
+			return events.nftCollection.GetTransfers(context.Background(), options)
+		},
+		on: func(events *testTypedEvents, listener func(event interface{}), options *EventListenerOptions) EventSubscription {
+			return events.nftCollection.OnTransfer(context.Background(), func(event *abi.TokenERC721Transfer) { listener(event) }, options)
+		},
+		check: func(t *testing.T, event interface{}) {
+			transfer := event.(*abi.TokenERC721Transfer)
+			assert.Equal(t, common.Address{}, transfer.From)
+			assert.Equal(t, testEventReceiver, transfer.To)
+			assert.Equal(t, int64(7), transfer.TokenId.Int64())
+		},
+	},
+	{
+		name: "NFTCollectionTokensMinted",
+		log: func(t *testing.T, blockNumber uint64) types.Log {
+			return newMockEventLog(t, abi.TokenERC721MetaData, "TokensMinted", blockNumber, testEventReceiver, big.NewInt(7), "ipfs://QmHash/7")
+		},
+		get: func(events *testTypedEvents, options EventQueryOptions) (interface{}, error) {
+			return events.nftCollection.GetTokensMinted(context.Background(), options)
+		},
+		on: func(events *testTypedEvents, listener func(event interface{}), options *EventListenerOptions) EventSubscription {
+			return events.nftCollection.OnTokensMinted(context.Background(), func(event *abi.TokenERC721TokensMinted) { listener(event) }, options)
+		},
+		check: func(t *testing.T, event interface{}) {
+			mint := event.(*abi.TokenERC721TokensMinted)
+			assert.Equal(t, testEventReceiver, mint.MintedTo)
+			assert.Equal(t, int64(7), mint.TokenIdMinted.Int64())
+			assert.Equal(t, "ipfs://QmHash/7", mint.Uri)
+		},
+	},
+	{
+		name: "NFTDropTransfer",
+		log: func(t *testing.T, blockNumber uint64) types.Log {
+			return newMockEventLog(t, abi.DropERC721MetaData, "Transfer", blockNumber, testEventLister, testEventReceiver, big.NewInt(3))
+		},
+		get: func(events *testTypedEvents, options EventQueryOptions) (interface{}, error) {
+			return events.nftDrop.GetTransfers(context.Background(), options)
+		},
+		on: func(events *testTypedEvents, listener func(event interface{}), options *EventListenerOptions) EventSubscription {
+			return events.nftDrop.OnTransfer(context.Background(), func(event *abi.DropERC721Transfer) { listener(event) }, options)
+		},
+		check: func(t *testing.T, event interface{}) {
+			transfer := event.(*abi.DropERC721Transfer)
+			assert.Equal(t, testEventLister, transfer.From)
+			assert.Equal(t, int64(3), transfer.TokenId.Int64())
+		},
+	},
+	{
+		name: "NFTDropTokensClaimed",
+		log: func(t *testing.T, blockNumber uint64) types.Log {
+			return newMockEventLog(t, abi.DropERC721MetaData, "TokensClaimed", blockNumber, big.NewInt(1), testEventLister, testEventReceiver, big.NewInt(5), big.NewInt(2))
+		},
+		get: func(events *testTypedEvents, options EventQueryOptions) (interface{}, error) {
+			return events.nftDrop.GetTokensClaimed(context.Background(), options)
+		},
+		on: func(events *testTypedEvents, listener func(event interface{}), options *EventListenerOptions) EventSubscription {
+			return events.nftDrop.OnTokensClaimed(context.Background(), func(event *abi.DropERC721TokensClaimed) { listener(event) }, options)
+		},
+		check: func(t *testing.T, event interface{}) {
+			claim := event.(*abi.DropERC721TokensClaimed)
+			assert.Equal(t, int64(1), claim.ClaimConditionIndex.Int64())
+			assert.Equal(t, testEventReceiver, claim.Receiver)
+			assert.Equal(t, int64(5), claim.StartTokenId.Int64())
+			assert.Equal(t, int64(2), claim.QuantityClaimed.Int64())
+		},
+	},
+	{
+		name: "NFTDropTokensLazyMinted",
+		log: func(t *testing.T, blockNumber uint64) types.Log {
+			return newMockEventLog(t, abi.DropERC721MetaData, "TokensLazyMinted", blockNumber, big.NewInt(0), big.NewInt(9), "ipfs://QmHash/", []byte{})
+		},
+		get: func(events *testTypedEvents, options EventQueryOptions) (interface{}, error) {
+			return events.nftDrop.GetTokensLazyMinted(context.Background(), options)
+		},
+		on: func(events *testTypedEvents, listener func(event interface{}), options *EventListenerOptions) EventSubscription {
+			return events.nftDrop.OnTokensLazyMinted(context.Background(), func(event *abi.DropERC721TokensLazyMinted) { listener(event) }, options)
+		},
+		check: func(t *testing.T, event interface{}) {
+			lazyMint := event.(*abi.DropERC721TokensLazyMinted)
+			assert.Equal(t, int64(9), lazyMint.EndTokenId.Int64())
+			assert.Equal(t, "ipfs://QmHash/", lazyMint.BaseURI)
+		},
+	},
+	{
+		name: "EditionTransferSingle",
+		log: func(t *testing.T, blockNumber uint64) types.Log {
+			return newMockERC1155Transfer(t, blockNumber, adminWallet, tertiaryWallet, []*big.Int{big.NewInt(3)}, []*big.Int{big.NewInt(2)})
+		},
+		get: func(events *testTypedEvents, options EventQueryOptions) (interface{}, error) {
+			return events.edition.GetSingleTransfers(context.Background(), options)
+		},
+		on: func(events *testTypedEvents, listener func(event interface{}), options *EventListenerOptions) EventSubscription {
+			return events.edition.OnTransferSingle(context.Background(), func(event *abi.TokenERC1155TransferSingle) { listener(event) }, options)
+		},
+		check: func(t *testing.T, event interface{}) {
+			transfer := event.(*abi.TokenERC1155TransferSingle)
+			assert.Equal(t, testEventReceiver, transfer.To)
+			assert.Equal(t, int64(3), transfer.Id.Int64())
+			assert.Equal(t, int64(2), transfer.Value.Int64())
+		},
+	},
+	{
+		name: "EditionTransferBatch",
+		log: func(t *testing.T, blockNumber uint64) types.Log {
+			return newMockERC1155Transfer(t, blockNumber, adminWallet, tertiaryWallet, []*big.Int{big.NewInt(0), big.NewInt(1)}, []*big.Int{big.NewInt(4), big.NewInt(1)})
+		},
+		get: func(events *testTypedEvents, options EventQueryOptions) (interface{}, error) {
+			return events.edition.GetBatchTransfers(context.Background(), options)
+		},
+		on: func(events *testTypedEvents, listener func(event interface{}), options *EventListenerOptions) EventSubscription {
+			return events.edition.OnTransferBatch(context.Background(), func(event *abi.TokenERC1155TransferBatch) { listener(event) }, options)
+		},
+		check: func(t *testing.T, event interface{}) {
+			transfer := event.(*abi.TokenERC1155TransferBatch)
+			assert.Equal(t, testEventReceiver, transfer.To)
+			assert.Len(t, transfer.Ids, 2)
+			assert.Equal(t, int64(4), transfer.Values[0].Int64())
+		},
+	},
+	{
+		name: "EditionTokensMinted",
+		log: func(t *testing.T, blockNumber uint64) types.Log {
+			return newMockEventLog(t, abi.TokenERC1155MetaData, "TokensMinted", blockNumber, testEventReceiver, big.NewInt(0), "ipfs://QmHash/0", big.NewInt(10))
+		},
+		get: func(events *testTypedEvents, options EventQueryOptions) (interface{}, error) {
+			return events.edition.GetTokensMinted(context.Background(), options)
+		},
+		on: func(events *testTypedEvents, listener func(event interface{}), options *EventListenerOptions) EventSubscription {
+			return events.edition.OnTokensMinted(context.Background(), func(event *abi.TokenERC1155TokensMinted) { listener(event) }, options)
+		},
+		check: func(t *testing.T, event interface{}) {
+			mint := event.(*abi.TokenERC1155TokensMinted)
+			assert.Equal(t, "ipfs://QmHash/0", mint.Uri)
+			assert.Equal(t, int64(10), mint.QuantityMinted.Int64())
+		},
+	},
+	{
+		name: "EditionDropTransferSingle",
+		log: func(t *testing.T, blockNumber uint64) types.Log {
+			return newMockERC1155Transfer(t, blockNumber, zeroAddress, tertiaryWallet, []*big.Int{big.NewInt(1)}, []*big.Int{big.NewInt(5)})
+		},
+		get: func(events *testTypedEvents, options EventQueryOptions) (interface{}, error) {
+			return events.editionDrop.GetSingleTransfers(context.Background(), options)
+		},
+		on: func(events *testTypedEvents, listener func(event interface{}), options *EventListenerOptions) EventSubscription {
+			return events.editionDrop.OnTransferSingle(context.Background(), func(event *abi.DropERC1155TransferSingle) { listener(event) }, options)
+		},
+		check: func(t *testing.T, event interface{}) {
+			transfer := event.(*abi.DropERC1155TransferSingle)
+			assert.Equal(t, common.Address{}, transfer.From)
+			assert.Equal(t, int64(1), transfer.Id.Int64())
+			assert.Equal(t, int64(5), transfer.Value.Int64())
+		},
+	},
+	{
+		name: "EditionDropTransferBatch",
+		log: func(t *testing.T, blockNumber uint64) types.Log {
+			return newMockERC1155Transfer(t, blockNumber, adminWallet, tertiaryWallet, []*big.Int{big.NewInt(0), big.NewInt(1)}, []*big.Int{big.NewInt(1), big.NewInt(2)})
+		},
+		get: func(events *testTypedEvents, options EventQueryOptions) (interface{}, error) {
+			return events.editionDrop.GetBatchTransfers(context.Background(), options)
+		},
+		on: func(events *testTypedEvents, listener func(event interface{}), options *EventListenerOptions) EventSubscription {
+			return events.editionDrop.OnTransferBatch(context.Background(), func(event *abi.DropERC1155TransferBatch) { listener(event) }, options)
+		},
+		check: func(t *testing.T, event interface{}) {
+			transfer := event.(*abi.DropERC1155TransferBatch)
+			assert.Equal(t, testEventLister, transfer.From)
+			assert.Len(t, transfer.Ids, 2)
+			assert.Equal(t, int64(2), transfer.Values[1].Int64())
+		},
+	},
+	{
+		name: "EditionDropTokensClaimed",
+		log: func(t *testing.T, blockNumber uint64) types.Log {
+			return newMockEventLog(t, abi.DropERC1155MetaData, "TokensClaimed", blockNumber, big.NewInt(0), testEventLister, testEventReceiver, big.NewInt(1), big.NewInt(5))
+		},
+		get: func(events *testTypedEvents, options EventQueryOptions) (interface{}, error) {
+			return events.editionDrop.GetTokensClaimed(context.Background(), options)
+		},
+		on: func(events *testTypedEvents, listener func(event interface{}), options *EventListenerOptions) EventSubscription {
+			return events.editionDrop.OnTokensClaimed(context.Background(), func(event *abi.DropERC1155TokensClaimed) { listener(event) }, options)
+		},
+		check: func(t *testing.T, event interface{}) {
+			claim := event.(*abi.DropERC1155TokensClaimed)
+			assert.Equal(t, testEventLister, claim.Claimer)
+			assert.Equal(t, int64(1), claim.TokenId.Int64())
+			assert.Equal(t, int64(5), claim.QuantityClaimed.Int64())
+		},
+	},
+	{
+		name: "EditionDropTokensLazyMinted",
+		log: func(t *testing.T, blockNumber uint64) types.Log {
+			return newMockEventLog(t, abi.DropERC1155MetaData, "TokensLazyMinted", blockNumber, big.NewInt(0), big.NewInt(1), "ipfs://QmHash/", []byte{})
+		},
+		get: func(events *testTypedEvents, options EventQueryOptions) (interface{}, error) {
+			return events.editionDrop.GetTokensLazyMinted(context.Background(), options)
+		},
+		on: func(events *testTypedEvents, listener func(event interface{}), options *EventListenerOptions) EventSubscription {
+			return events.editionDrop.OnTokensLazyMinted(context.Background(), func(event *abi.DropERC1155TokensLazyMinted) { listener(event) }, options)
+		},
+		check: func(t *testing.T, event interface{}) {
+			lazyMint := event.(*abi.DropERC1155TokensLazyMinted)
+			assert.Equal(t, int64(1), lazyMint.EndTokenId.Int64())
+			assert.Equal(t, "ipfs://QmHash/", lazyMint.BaseURI)
+		},
+	},
+	{
+		name: "MarketplaceListingAdded",
+		log: func(t *testing.T, blockNumber uint64) types.Log {
+			listing := abi.IMarketplaceListing{
+				ListingId:            big.NewInt(1),
+				TokenOwner:           testEventLister,
+				AssetContract:        testEventAsset,
+				TokenId:              big.NewInt(42),
+				StartTime:            big.NewInt(1000),
+				EndTime:              big.NewInt(2000),
+				Quantity:             big.NewInt(1),
+				Currency:             common.HexToAddress(nativeTokenAddress),
+				ReservePricePerToken: big.NewInt(0),
+				BuyoutPricePerToken:  big.NewInt(500),
+			}
+			return newMockEventLog(t, abi.MarketplaceMetaData, "ListingAdded", blockNumber, big.NewInt(1), testEventAsset, testEventLister, listing)
+		},
+		get: func(events *testTypedEvents, options EventQueryOptions) (interface{}, error) {
+			return events.marketplace.GetListingsAdded(context.Background(), options)
+		},
+		on: func(events *testTypedEvents, listener func(event interface{}), options *EventListenerOptions) EventSubscription {
+			return events.marketplace.OnListingAdded(context.Background(), func(event *abi.MarketplaceListingAdded) { listener(event) }, options)
+		},
+		check: func(t *testing.T, event interface{}) {
+			added := event.(*abi.MarketplaceListingAdded)
+			assert.Equal(t, int64(1), added.ListingId.Int64())
+			assert.Equal(t, testEventLister, added.Lister)
+			assert.Equal(t, int64(42), added.Listing.TokenId.Int64())
+			assert.Equal(t, int64(500), added.Listing.BuyoutPricePerToken.Int64())
+		},
+	},
+	{
+		name: "MarketplaceListingUpdated",
+		log: func(t *testing.T, blockNumber uint64) types.Log {
+			return newMockEventLog(t, abi.MarketplaceMetaData, "ListingUpdated", blockNumber, big.NewInt(3), testEventLister)
+		},
+		get: func(events *testTypedEvents, options EventQueryOptions) (interface{}, error) {
+			return events.marketplace.GetListingsUpdated(context.Background(), options)
+		},
+		on: func(events *testTypedEvents, listener func(event interface{}), options *EventListenerOptions) EventSubscription {
+			return events.marketplace.OnListingUpdated(context.Background(), func(event *abi.MarketplaceListingUpdated) { listener(event) }, options)
+		},
+		check: func(t *testing.T, event interface{}) {
+			updated := event.(*abi.MarketplaceListingUpdated)
+			assert.Equal(t, int64(3), updated.ListingId.Int64())
+			assert.Equal(t, testEventLister, updated.ListingCreator)
+		},
+	},
+	{
+		name: "MarketplaceListingRemoved",
+		log: func(t *testing.T, blockNumber uint64) types.Log {
+			return newMockEventLog(t, abi.MarketplaceMetaData, "ListingRemoved", blockNumber, big.NewInt(2), testEventLister)
+		},
+		get: func(events *testTypedEvents, options EventQueryOptions) (interface{}, error) {
+			return events.marketplace.GetListingsRemoved(context.Background(), options)
+		},
+		on: func(events *testTypedEvents, listener func(event interface{}), options *EventListenerOptions) EventSubscription {
+			return events.marketplace.OnListingRemoved(context.Background(), func(event *abi.MarketplaceListingRemoved) { listener(event) }, options)
+		},
+		check: func(t *testing.T, event interface{}) {
+			removed := event.(*abi.MarketplaceListingRemoved)
+			assert.Equal(t, int64(2), removed.ListingId.Int64())
+			assert.Equal(t, testEventLister, removed.ListingCreator)
+		},
+	},
+	{
+		name: "MarketplaceNewSale",
+		log: func(t *testing.T, blockNumber uint64) types.Log {
+			return newMockEventLog(t, abi.MarketplaceMetaData, "NewSale", blockNumber, big.NewInt(7), testEventAsset, testEventLister, testEventReceiver, big.NewInt(2), big.NewInt(1000))
+		},
+		get: func(events *testTypedEvents, options EventQueryOptions) (interface{}, error) {
+			return events.marketplace.GetNewSales(context.Background(), options)
+		},
+		on: func(events *testTypedEvents, listener func(event interface{}), options *EventListenerOptions) EventSubscription {
+			return events.marketplace.OnNewSale(context.Background(), func(event *abi.MarketplaceNewSale) { listener(event) }, options)
+		},
+		check: func(t *testing.T, event interface{}) {
+			sale := event.(*abi.MarketplaceNewSale)
+			assert.Equal(t, int64(7), sale.ListingId.Int64())
+			assert.Equal(t, testEventReceiver, sale.Buyer)
+			assert.Equal(t, int64(2), sale.QuantityBought.Int64())
+			assert.Equal(t, int64(1000), sale.TotalPricePaid.Int64())
+		},
+	},
+	{
+		name: "MarketplaceNewOffer",
+		log: func(t *testing.T, blockNumber uint64) types.Log {
+			return newMockEventLog(
+				t, abi.MarketplaceMetaData, "NewOffer", blockNumber,
+				big.NewInt(4), testEventReceiver, uint8(1), big.NewInt(1), big.NewInt(300), common.HexToAddress(nativeTokenAddress),
+			)
+		},
+		get: func(events *testTypedEvents, options EventQueryOptions) (interface{}, error) {
+			return events.marketplace.GetNewOffers(context.Background(), options)
+		},
+		on: func(events *testTypedEvents, listener func(event interface{}), options *EventListenerOptions) EventSubscription {
+			return events.marketplace.OnNewOffer(context.Background(), func(event *abi.MarketplaceNewOffer) { listener(event) }, options)
+		},
+		check: func(t *testing.T, event interface{}) {
+			offer := event.(*abi.MarketplaceNewOffer)
+			assert.Equal(t, int64(4), offer.ListingId.Int64())
+			assert.Equal(t, testEventReceiver, offer.Offeror)
+			assert.Equal(t, uint8(1), offer.ListingType)
+			assert.Equal(t, int64(300), offer.TotalOfferAmount.Int64())
+		},
+	},
+	{
+		name: "MarketplaceAuctionClosed",
+		log: func(t *testing.T, blockNumber uint64) types.Log {
+			return newMockEventLog(t, abi.MarketplaceMetaData, "AuctionClosed", blockNumber, big.NewInt(5), testEventLister, false, testEventLister, testEventReceiver)
+		},
+		get: func(events *testTypedEvents, options EventQueryOptions) (interface{}, error) {
+			return events.marketplace.GetAuctionsClosed(context.Background(), options)
+		},
+		on: func(events *testTypedEvents, listener func(event interface{}), options *EventListenerOptions) EventSubscription {
+			return events.marketplace.OnAuctionClosed(context.Background(), func(event *abi.MarketplaceAuctionClosed) { listener(event) }, options)
+		},
+		check: func(t *testing.T, event interface{}) {
+			closed := event.(*abi.MarketplaceAuctionClosed)
+			assert.Equal(t, int64(5), closed.ListingId.Int64())
+			assert.False(t, closed.Cancelled)
+			assert.Equal(t, testEventReceiver, closed.WinningBidder)
+		},
+	},
+}
+
+// Get the raw log of a typed event struct
+func getRawLog(event interface{}) types.Log {
+	return reflect.ValueOf(event).Elem().FieldByName("Raw").Interface().(types.Log)
+}
+
+func TestTypedEventsGet(t *testing.T) {
+	for _, testCase := range typedEventCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			server := newMockRpcServer()
+			defer server.Close()
+
+			// Only the logs of the queried event are decoded, in the order of the options
+			unrelated := newMockTokenLog(1, []common.Hash{common.HexToHash("0x01")}, nil)
+			handleMockTokenLogs(t, server, []types.Log{unrelated, testCase.log(t, 2), testCase.log(t, 3)})
+			result, err := testCase.get(newTestTypedEvents(t, server.helper(t)), EventQueryOptions{Order: EventOrderDescending})
+			assert.Nil(t, err)
+
+			events := reflect.ValueOf(result)
+			assert.Equal(t, 2, events.Len())
+			for i, blockNumber := range []uint64{3, 2} {
+				event := events.Index(i).Interface()
+				assert.Equal(t, blockNumber, getRawLog(event).BlockNumber)
+				testCase.check(t, event)
+			}
+		})
+	}
+}
+
+func TestTypedEventsOn(t *testing.T) {
+	for _, testCase := range typedEventCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			service := &mockLogsService{blockNumber: 10}
+			server := newMockHttpLogsServer(t, service)
+			defer server.Close()
+
+			fromBlock := uint64(11)
+			received := make(chan interface{}, 10)
+			subscription := testCase.on(
+				newTestTypedEvents(t, newTestLogsHelper(t, server.URL)),
+				func(event interface{}) { received <- event },
+				&EventListenerOptions{FromBlock: &fromBlock, PollInterval: time.Millisecond * 10},
+			)
+			defer subscription.Unsubscribe()
+
+			service.mineLog(testCase.log(t, 0))
+
+			select {
+			case event := <-received:
+				assert.Equal(t, uint64(11), getRawLog(event).BlockNumber)
+				testCase.check(t, event)
+			case <-time.After(time.Second * 2):
+				t.Fatal("The event wasn't received")
+			}
+		})
+	}
+}
@@ -6,13 +6,47 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/web3sdks/go-sdk/v2/abi"
 )
 
 type SDKOptions struct {
 	PrivateKey string
+	// Signer used to sign transactions and messages, takes precedence over PrivateKey
+	Signer     Signer
 	GatewayUrl string
 	HttpClient *http.Client
+	// Default options used to wait for transactions to be mined
+	TxWait *TxWaitOptions
+	// Strategy used to compute the fees of every transaction
+	FeeStrategy FeeStrategy
+	// Type of transactions to send, defaults to dynamic fee transactions on chains that support them
+	TransactionType TransactionType
+	// Generate an access list for every transaction with eth_createAccessList
+	CreateAccessList bool
+	// Additional RPC URLs of the same chain. When set, requests fail over between the RPC URL the
+	// SDK is created with and these, see FailoverTransport
+	RpcUrls []string
+	// Options controlling the failover between the RPC URLs, which requires the RpcUrls option
+	Failover *FailoverOptions
+	// Rate limit the requests to the RPC URLs and retry the ones that fail, see RetryTransport
+	Retry *RetryOptions
+	// Options controlling how bulk reads like GetAll are aggregated, see ReadBatchOptions
+	ReadBatch *ReadBatchOptions
+	// Cache the reads of contracts and storage, see CacheOptions. Reads are not cached if nil
+	Cache *CacheOptions
+	// Maximum number of tokens fetched at the same time by methods like GetAll, defaults to 16
+	FetchConcurrency int
+}
+
+// The result of a successfully mined transaction. The transaction itself is embedded, so
+// methods like Hash() can be called directly on the result.
+type TransactionResult struct {
+	*types.Transaction
+	// The receipt of the transaction, including the status, gas used, logs and block number
+	Receipt *types.Receipt
+	// The price per unit of gas that was actually paid for the transaction
+	EffectiveGasPrice *big.Int
 }
 
 type Metadata struct {
@@ -54,6 +88,14 @@ type EditionMetadata struct {
 	Supply   int
 }
 
+// Page of the tokens to get with the GetAll methods of the NFT contracts.
+type QueryAllParams struct {
+	// Index of the first token to get, starting from 0
+	Start int
+	// Number of tokens to get, or all the tokens after the start if 0
+	Count int
+}
+
 type EditionMetadataOwner struct {
 	Metadata      *NFTMetadata
 	Supply        int
@@ -100,11 +142,17 @@ type CurrencyValue struct {
 	Decimals     int
 	Value        *big.Int
 	DisplayValue float64
+	// Exact decimal string of the display value, like "1.5"
+	DisplayValueString string
 }
 
 type TokenAmount struct {
 	ToAddress string
 	Amount    float64
+	// Exact decimal amount, like "1.5", used instead of Amount when set
+	AmountString string
+	// Amount in the smallest unit of the token, used instead of Amount and AmountString when set
+	AmountBigInt *big.Int
 }
 
 type WrappedToken struct {
@@ -130,6 +178,10 @@ type Signature721PayloadInput struct {
 	Metadata             *NFTMetadataInput
 	RoyaltyRecipient     string
 	RoyaltyBps           int
+	// Exact decimal price, like "1.5", used instead of Price when set
+	PriceString string
+	// Price in the smallest unit of the currency, used instead of Price and PriceString when set
+	PriceBigInt *big.Int
 }
 
 type Signature721PayloadOutput struct {
@@ -144,6 +196,10 @@ type Signature721PayloadOutput struct {
 	RoyaltyBps           int
 	Uri                  string
 	Uid                  [32]byte
+	// Exact decimal price, like "1.5", used instead of Price when set
+	PriceString string
+	// Price in the smallest unit of the currency, used instead of Price and PriceString when set
+	PriceBigInt *big.Int
 }
 
 type SignedPayload721 struct {
@@ -162,6 +218,10 @@ type Signature1155PayloadInput struct {
 	RoyaltyRecipient     string
 	RoyaltyBps           int
 	Quantity             int
+	// Exact decimal price, like "1.5", used instead of Price when set
+	PriceString string
+	// Price in the smallest unit of the currency, used instead of Price and PriceString when set
+	PriceBigInt *big.Int
 }
 
 type Signature1155PayloadInputWithTokenId struct {
@@ -176,6 +236,10 @@ type Signature1155PayloadInputWithTokenId struct {
 	RoyaltyRecipient     string
 	RoyaltyBps           int
 	Quantity             int
+	// Exact decimal price, like "1.5", used instead of Price when set
+	PriceString string
+	// Price in the smallest unit of the currency, used instead of Price and PriceString when set
+	PriceBigInt *big.Int
 }
 
 type Signature1155PayloadOutput struct {
@@ -192,6 +256,10 @@ type Signature1155PayloadOutput struct {
 	Quantity             int
 	Uri                  string
 	Uid                  [32]byte
+	// Exact decimal price, like "1.5", used instead of Price when set
+	PriceString string
+	// Price in the smallest unit of the currency, used instead of Price and PriceString when set
+	PriceBigInt *big.Int
 }
 
 type SignedPayload1155 struct {
@@ -202,6 +270,10 @@ type SignedPayload1155 struct {
 type MultiwrapERC20 struct {
 	ContractAddress string
 	Quantity        float64
+	// Exact decimal quantity, like "1.5", used instead of Quantity when set
+	QuantityString string
+	// Quantity in the smallest unit of the token, used instead of Quantity and QuantityString when set
+	QuantityBigInt *big.Int
 }
 
 type MultiwrapERC721 struct {
@@ -482,6 +554,11 @@ type NewDirectListing struct {
 	Quantity                 int
 	CurrencyContractAddress  string
 	BuyoutPricePerToken      float64
+	// Exact decimal price per token, like "1.5", used instead of BuyoutPricePerToken when set
+	BuyoutPricePerTokenString string
+	// Price per token in the smallest unit of the currency, used instead of BuyoutPricePerToken and
+	// BuyoutPricePerTokenString when set
+	BuyoutPricePerTokenBigInt *big.Int
 }
 
 func (listing *NewDirectListing) fillDefaults() {
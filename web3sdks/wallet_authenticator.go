@@ -1,6 +1,7 @@
 package web3sdks
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
@@ -44,12 +45,9 @@ type WalletAuthenticator struct {
 	*ProviderHandler
 }
 
-func newWalletAuthenticator(provider *ethclient.Client, privateKey string) (*WalletAuthenticator, error) {
-	handler, err := NewProviderHandler(provider, privateKey)
-	if err != nil {
-		return nil, err
-	}
-
+func newWalletAuthenticator(provider *ethclient.Client, signer Signer) (*WalletAuthenticator, error) {
+	// The authenticator gets its own handler so that switching its wallet doesn't affect the SDK
+	handler := NewProviderHandlerWithSigner(provider, signer)
 	return &WalletAuthenticator{handler}, nil
 }
 
@@ -103,6 +101,9 @@ func (auth *WalletAuthenticator) Login(
 
 	message := auth.generateMessage(payloadData)
 	signature, err := auth.signMessage(message)
+	if err != nil {
+		return nil, err
+	}
 
 	return &WalletLoginPayload{
 		Payload:   payloadData,
@@ -409,8 +410,8 @@ func (auth *WalletAuthenticator) recoverAddress(message string, signature []byte
 }
 
 func (auth *WalletAuthenticator) requireSigner() error {
-	if auth.GetPrivateKey() == nil {
-		return errors.New("This action requires a connected wallet. Please pass a valid private key to the SDK")
+	if auth.GetSigner() == nil {
+		return errors.New("This action requires a connected wallet. Please pass a valid private key or signer to the SDK")
 	}
 
 	return nil
@@ -422,16 +423,7 @@ func (auth *WalletAuthenticator) signMessage(message string) ([]byte, error) {
 		return nil, err
 	}
 
-	messageByte := []byte(message)
-	messageHash := crypto.Keccak256Hash(messageByte)
-
-	privateKey := auth.GetPrivateKey()
-	signatureHash, err := crypto.Sign(messageHash.Bytes(), privateKey)
-	if err != nil {
-		return nil, err
-	}
-
-	return signatureHash, nil
+	return auth.GetSigner().SignMessage(context.Background(), []byte(message))
 }
 
 func (auth *WalletAuthenticator) base64Encode(data string) string {
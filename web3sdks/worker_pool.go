@@ -0,0 +1,59 @@
+package web3sdks
+
+import (
+	"context"
+	"sync"
+)
+
+const defaultFetchConcurrency = 16
+
+// Set the maximum number of tokens fetched at the same time by the methods fetching many tokens,
+// like GetAll. Defaults to 16.
+func (handler *ProviderHandler) SetFetchConcurrency(limit int) {
+	handler.fetchConcurrency = limit
+}
+
+func (handler *ProviderHandler) getFetchConcurrency() int {
+	if handler.fetchConcurrency <= 0 {
+		return defaultFetchConcurrency
+	}
+
+	return handler.fetchConcurrency
+}
+
+// Call work for every index from 0 to count, from a pool of at most limit workers. No new work is
+// started once the context is done, and this returns once all the started work is finished.
+func forEachInParallel(ctx context.Context, count int, limit int, work func(i int)) {
+	if limit <= 0 {
+		limit = defaultFetchConcurrency
+	}
+
+	indexes := make(chan int)
+	wg := sync.WaitGroup{}
+	for worker := 0; worker < limit && worker < count; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				work(i)
+			}
+		}()
+	}
+
+	defer func() {
+		close(indexes)
+		wg.Wait()
+	}()
+
+	for i := 0; i < count; i++ {
+		if ctx.Err() != nil {
+			return
+		}
+
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package web3sdks
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// Mock IPFS gateway serving the metadata of every token, keeping track of the concurrent requests
+type mockGateway struct {
+	*httptest.Server
+	mu       sync.Mutex
+	hits     int
+	inFlight int
+	peak     int
+}
+
+func newMockGateway(serve func(w http.ResponseWriter, tokenId string)) *mockGateway {
+	gateway := &mockGateway{}
+	gateway.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gateway.mu.Lock()
+		gateway.hits += 1
+		gateway.inFlight += 1
+		if gateway.inFlight > gateway.peak {
+			gateway.peak = gateway.inFlight
+		}
+		gateway.mu.Unlock()
+
+		defer func() {
+			gateway.mu.Lock()
+			gateway.inFlight -= 1
+			gateway.mu.Unlock()
+		}()
+
+		serve(w, r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:])
+	}))
+
+	return gateway
+}
+
+func (gateway *mockGateway) hitCount() int {
+	gateway.mu.Lock()
+	defer gateway.mu.Unlock()
+	return gateway.hits
+}
+
+func newTestWorkerPoolERC721(t *testing.T, gatewayUrl string) (*mockRpcServer, *ERC721) {
+	server := newMockRpcServer()
+	handleMockDropCalls(t, server, 50, common.HexToAddress(adminWallet), nil)
+
+	helper := server.helper(t)
+	helper.SetFetchConcurrency(4)
+
+	erc721, err := newERC721(helper.GetProvider(), helper.getAddress(), helper.ProviderHandler, newIpfsStorage(gatewayUrl, http.DefaultClient))
+	assert.Nil(t, err)
+
+	return server, erc721
+}
+
+func newTestTokenIds(count int) []*big.Int {
+	tokenIds := []*big.Int{}
+	for i := count - 1; i >= 0; i-- {
+		tokenIds = append(tokenIds, big.NewInt(int64(i)))
+	}
+
+	return tokenIds
+}
+
+func TestWorkerPoolFetchesNFTsInOrder(t *testing.T) {
+	gateway := newMockGateway(func(w http.ResponseWriter, tokenId string) {
+		if tokenId == "7" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"name":"NFT ` + tokenId + `"}`))
+	})
+	defer gateway.Close()
+
+	server, erc721 := newTestWorkerPoolERC721(t, gateway.URL)
+	defer server.Close()
+
+	// Token ids in descending order
+	nfts, err := erc721.fetchNFTsByTokenId(context.Background(), newTestTokenIds(50))
+
+	var partialErr *PartialResultError
+	assert.True(t, errors.As(err, &partialErr))
+	assert.Len(t, partialErr.TokenErrors, 1)
+	assert.Equal(t, int64(7), partialErr.TokenErrors[0].TokenId.Int64())
+
+	assert.Len(t, nfts, 49)
+	assert.Equal(t, "NFT 49", nfts[0].Metadata.Name)
+	assert.Equal(t, int64(49), nfts[0].Metadata.Id.Int64())
+	assert.Equal(t, "NFT 0", nfts[48].Metadata.Name)
+	assert.Equal(t, adminWallet, nfts[48].Owner)
+
+	assert.Equal(t, 50, gateway.hitCount())
+	assert.LessOrEqual(t, gateway.peak, 4)
+}
+
+func TestWorkerPoolStopsWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	gateway := newMockGateway(func(w http.ResponseWriter, tokenId string) {
+		cancel()
+		w.Write([]byte(`{"name":"NFT"}`))
+	})
+	defer gateway.Close()
+
+	server, erc721 := newTestWorkerPoolERC721(t, gateway.URL)
+	defer server.Close()
+
+	nfts, err := erc721.fetchNFTsByTokenId(ctx, newTestTokenIds(50))
+	assert.Nil(t, nfts)
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Less(t, gateway.hitCount(), 50)
+}
+
+func TestWorkerPoolFetchesListingAssets(t *testing.T) {
+	gateway := newMockGateway(func(w http.ResponseWriter, tokenId string) {
+		if tokenId == "7" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"name":"NFT ` + tokenId + `"}`))
+	})
+	defer gateway.Close()
+
+	server := newMockRpcServer()
+	defer server.Close()
+	handleMockDropCalls(t, server, 50, common.HexToAddress(adminWallet), nil)
+
+	helper := server.helper(t)
+	helper.SetFetchConcurrency(4)
+
+	tokenIds := newTestTokenIds(50)
+	contracts := []common.Address{}
+	for range tokenIds {
+		contracts = append(contracts, common.HexToAddress(secondaryWallet))
+	}
+
+	assets, errs, err := fetchTokenMetadatasForContracts(context.Background(), helper, contracts, tokenIds, newIpfsStorage(gateway.URL, http.DefaultClient))
+	assert.Nil(t, err)
+	assert.Equal(t, "NFT 49", assets[0].Name)
+	assert.Nil(t, assets[42])
+	assert.NotNil(t, errs[42])
+	assert.Equal(t, 50, gateway.hitCount())
+	assert.LessOrEqual(t, gateway.peak, 4)
+
+	// Only the failed listing gets an error, and only while it isn't filtered out
+	partialErr := &PartialListingsError{}
+	assert.True(t, errors.As(newPartialListingsError(tokenIds, errs), &partialErr))
+	assert.Len(t, partialErr.ListingErrors, 1)
+	assert.Equal(t, int64(7), partialErr.ListingErrors[0].ListingId.Int64())
+	assert.NotNil(t, filterPartialListingsError(partialErr, []*DirectListing{{Id: "7"}, {Id: "8"}}))
+	assert.Nil(t, filterPartialListingsError(partialErr, []*DirectListing{{Id: "8"}}))
+}